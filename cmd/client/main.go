@@ -4,51 +4,163 @@ import (
 	"context"
 	"fmt"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
 	"log"
+	"log/slog"
 	"os"
 	"os/signal"
 	"soa_hw_2/internal/client"
+	"soa_hw_2/internal/config"
+	"soa_hw_2/internal/tlsconfig"
+	"soa_hw_2/internal/tracing"
 	"syscall"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"golang.org/x/term"
 )
 
 func main() {
-	address := "dns:///mafiaserver:9000"
+	cfg, err := config.LoadClientConfig(os.Args[1:])
+	if err != nil {
+		log.Fatalf("failed to load config: %v\n", err)
+	}
+
+	logger, _ := config.NewLogger(os.Stderr, cfg.LogLevel, "text")
+	slog.SetDefault(logger)
+
+	tlsCfg := cfg.TLS
+	creds, err := tlsconfig.ClientCredentials(tlsCfg.Enabled, tlsCfg.CA, tlsCfg.Cert, tlsCfg.Key, tlsCfg.ServerName)
+	if err != nil {
+		log.Fatalf("failed to init TLS: %v\n", err)
+	}
+
+	shutdownTracing, err := tracing.Setup(context.Background(), "mafia-client", cfg.Tracing.Exporter, cfg.Tracing.Endpoint)
+	if err != nil {
+		log.Fatalf("failed to init tracing: %v\n", err)
+	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
 
-	var username string
-	fmt.Printf("Enter your username: ")
-	_, _ = fmt.Scanf("%s", &username)
-
-	conn, err := createConnection(address)
+	conn, err := createConnection(cfg.Server, creds)
 	if err != nil {
-		log.Fatalf("failed to connect to %s: %v\n", address, err)
+		log.Fatalf("failed to connect to %s: %v\n", cfg.Server, err)
 	}
 	defer conn.Close()
 
-	cli, err := client.NewClient(ctx, username, conn)
+	var token string
+	if cfg.Spectate == "" {
+		token, err = login(ctx, conn, cfg.Username, cfg.Password, cfg.Register)
+		if err != nil {
+			log.Fatalf("failed to log in: %v\n", err)
+		}
+	}
+
+	var cli *client.Client
+	switch {
+	case cfg.Moderate:
+		cli, err = client.NewModeratorClient(ctx, token, cfg.Session, conn)
+	case cfg.Spectate != "":
+		cli, err = client.NewSpectatorClient(ctx, cfg.Spectate, conn)
+	case cfg.Resume:
+		cli, err = client.NewResumeClient(ctx, token, cfg.Session, conn)
+	default:
+		cli, err = client.NewClient(ctx, token, conn)
+	}
 	if err != nil {
 		log.Fatalf("failed to init gRPC client: %v\n", err)
 	}
 
 	messenger := client.NewMessenger(ctx)
 	handler := client.NewHandler(cli, messenger)
+	go cli.ForwardEvents()
+
+	if cfg.Script != "" {
+		err = runScript(cli, handler.Commands(), cfg.Script)
+		cancel()
+		_ = shutdownTracing(context.Background())
+		if err != nil {
+			log.Fatalf("script failed: %v\n", err)
+		}
+		return
+	}
+
+	if cfg.TUI {
+		err = client.NewTUI(cli, handler, messenger).Run()
+		cancel()
+		_ = shutdownTracing(context.Background())
+		if err != nil {
+			log.Fatalf("terminal UI failed: %v\n", err)
+		}
+		if cli.Err() != nil {
+			log.Fatalf("\n\nServer closed\n")
+		}
+		return
+	}
 
 	go messenger.Start()
 	go handler.Start()
-	go cli.ForwardEvents()
 
 	<-stop
 	cancel()
+	_ = shutdownTracing(context.Background())
+}
+
+func login(ctx context.Context, conn *grpc.ClientConn, username string, password string, register bool) (string, error) {
+	if username == "" {
+		fmt.Printf("Enter your username: ")
+		_, _ = fmt.Scanln(&username)
+	}
+
+	var err error
+	if password == "" {
+		fmt.Printf("Enter your password: ")
+		password, err = readPassword()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if register {
+		return client.Register(ctx, username, password, conn)
+	}
+	return client.Login(ctx, username, password, conn)
+}
+
+func runScript(cli *client.Client, commands *client.Commands, path string) error {
+	input := os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		input = file
+	}
+
+	return client.NewScript(cli, commands, os.Stdout).Run(input)
+}
+
+func readPassword() (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		var password string
+		_, _ = fmt.Scanln(&password)
+		return password, nil
+	}
+
+	password, err := term.ReadPassword(fd)
+	fmt.Println()
+	return string(password), err
 }
 
-func createConnection(address string) (*grpc.ClientConn, error) {
+func createConnection(address string, creds credentials.TransportCredentials) (*grpc.ClientConn, error) {
 	conn, err := grpc.Dial(
 		address,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
 	)
 
 	return conn, err
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"path/filepath"
+	"soa_hw_2/internal/client"
+	"soa_hw_2/internal/server"
+	"soa_hw_2/internal/store"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func main() {
+	dataDir := flag.String("data-dir", "data", "server data directory with recorded games")
+	speed := flag.Float64("speed", 1, "playback speed, 2 is twice faster")
+	list := flag.Bool("list", false, "list recorded games instead of replaying")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [flags] {game id}\n", filepath.Base(flag.CommandLine.Name()))
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	games, err := store.NewFileGameStore(filepath.Join(*dataDir, "games"))
+	if err != nil {
+		log.Fatalf("failed to open game history: %v\n", err)
+	}
+
+	if *list {
+		records, err := games.List()
+		if err != nil {
+			log.Fatalf("failed to list games: %v\n", err)
+		}
+		for _, record := range records {
+			fmt.Printf("%s %s %d players, winners: %s\n", record.ID, record.FinishedAt.Format(time.DateTime),
+				len(record.Players), client.TeamToString(record.Winner))
+		}
+		return
+	}
+
+	if flag.NArg() != 1 || *speed <= 0 {
+		flag.Usage()
+		return
+	}
+
+	id, err := uuid.Parse(flag.Arg(0))
+	if err != nil {
+		log.Fatalf("invalid game id: %v\n", err)
+	}
+	record, err := games.Get(id)
+	if err != nil {
+		log.Fatalf("failed to load game %s: %v\n", id, err)
+	}
+	timeline, err := server.GameRecordToTimeline(record)
+	if err != nil {
+		log.Fatalf("failed to load game %s: %v\n", id, err)
+	}
+
+	ctx := context.Background()
+	messenger := client.NewMessenger(ctx)
+	handler := client.NewReplayHandler(messenger)
+	messenger.StartOutput()
+
+	client.Replay(ctx, timeline, *speed, handler.HandleEvent)
+	messenger.Stop()
+}
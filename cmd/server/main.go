@@ -1,52 +1,304 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
+	"log/slog"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"soa_hw_2/internal/auth"
+	"soa_hw_2/internal/config"
+	"soa_hw_2/internal/gateway"
 	"soa_hw_2/internal/pb"
 	"soa_hw_2/internal/server"
-	"time"
+	"soa_hw_2/internal/store"
+	"soa_hw_2/internal/tlsconfig"
+	"soa_hw_2/internal/tracing"
+	"soa_hw_2/internal/web"
+	"syscall"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/test/bufconn"
 )
 
-const (
-	DefaultTimeout   = 10 * time.Second
-	DefaultKeepAlive = 500 * time.Millisecond
-)
+var publicMethods = []string{
+	"/mafia.Mafia/Register",
+	"/mafia.Mafia/Login",
+	"/mafia.Mafia/Spectate",
+}
+
+var healthMethods = []string{
+	"/grpc.health.v1.Health/Check",
+	"/grpc.health.v1.Health/Watch",
+}
+
+var reflectionMethods = []string{
+	"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo",
+}
 
 func main() {
-	address := "0.0.0.0:9000"
-	srv, lis, err := registerServer(address)
+	cfg, err := config.LoadServerConfig(os.Args[1:])
+	if err != nil {
+		log.Fatalf("failed to load config: %v\n", err)
+	}
+
+	logger, _ := config.NewLogger(os.Stderr, cfg.LogLevel, cfg.LogFormat)
+	slog.SetDefault(logger)
+
+	creds, err := tlsconfig.ServerCredentials(cfg.TLS.Cert, cfg.TLS.Key, cfg.TLS.ClientCA)
 	if err != nil {
-		log.Fatalf("server registration failed on %s: %v\n", address, err)
+		fatal("failed to init TLS", err)
+	}
+
+	shutdownTracing, err := tracing.Setup(context.Background(), "mafia-server", cfg.Tracing.Exporter, cfg.Tracing.Endpoint)
+	if err != nil {
+		fatal("failed to init tracing", err)
+	}
+
+	secret := []byte(cfg.Auth.Secret)
+	if len(secret) == 0 {
+		secret, err = auth.NewRandomSecret()
+		if err != nil {
+			fatal("failed to init auth", err)
+		}
+	}
+	signer := auth.NewSigner(secret, cfg.Auth.TokenTTL)
+
+	stores := server.Stores{}
+	stores.Accounts, err = store.NewFileAccountStore(cfg.AccountsPath())
+	if err != nil {
+		fatal("failed to open account store", err)
+	}
+
+	if cfg.PersistSessions {
+		stores.Sessions, err = store.NewFileSessionStore(cfg.SessionsDir())
+		if err != nil {
+			fatal("failed to open session store", err)
+		}
+	}
+
+	stores.Games, err = store.NewFileGameStore(cfg.GamesDir())
+	if err != nil {
+		fatal("failed to open game history", err)
+	}
+
+	stores.Ratings, err = store.NewFileRatingStore(cfg.RatingsPath())
+	if err != nil {
+		fatal("failed to open ratings", err)
+	}
+
+	mafiaServer := server.NewMafiaServer(server.GameSettings{
+		MafiaCount:    cfg.Game.MafiaCount,
+		SheriffCount:  cfg.Game.SheriffCount,
+		CivilianCount: cfg.Game.CivilianCount,
+		MinPlayers:    cfg.Game.MinPlayers,
+		PhaseDuration: cfg.Game.PhaseDuration,
+	}, server.MatchSettings{
+		Window:       cfg.Matchmaking.Window,
+		WindowGrowth: cfg.Matchmaking.WindowGrowth,
+	}, signer, stores, logger)
+
+	err = mafiaServer.RestoreSessions(cfg.ResumeTimeout)
+	if err != nil {
+		fatal("failed to restore sessions", err)
+	}
+
+	srv, healthSrv, lis, err := registerServer(cfg, mafiaServer, signer, creds)
+	if err != nil {
+		fatal("server registration failed", err, "address", cfg.Address)
+	}
+
+	adminSrv, adminLis, err := registerAdminServer(cfg.AdminAddress, mafiaServer)
+	if err != nil {
+		fatal("admin server registration failed", err, "address", cfg.AdminAddress)
+	}
+
+	go func() {
+		err := adminSrv.Serve(adminLis)
+		if err != nil {
+			fatal("admin server failed", err)
+		}
+	}()
+
+	var metricsSrv *http.Server
+	if cfg.MetricsAddress != "" {
+		metricsSrv = registerMetricsServer(cfg.MetricsAddress, mafiaServer)
+		go func() {
+			err := metricsSrv.ListenAndServe()
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				fatal("metrics server failed", err)
+			}
+		}()
 	}
 
+	var gatewaySrv *http.Server
+	var gatewayGRPC *grpc.Server
+	if cfg.GatewayAddress != "" {
+		var gatewayLis net.Listener
+		gatewaySrv, gatewayGRPC, gatewayLis, err = registerGateway(cfg, mafiaServer, signer)
+		if err != nil {
+			fatal("gateway registration failed", err, "address", cfg.GatewayAddress)
+		}
+		go func() {
+			err := gatewaySrv.ServeTLS(gatewayLis, cfg.TLS.Cert, cfg.TLS.Key)
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				fatal("gateway failed", err)
+			}
+		}()
+	}
+
+	stop := make(chan os.Signal, 2)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		sig := <-stop
+		slog.Info("shutting down", "signal", sig.String(), "timeout", cfg.ShutdownTimeout.String())
+		go func() {
+			sig := <-stop
+			slog.Warn("second signal received, exiting immediately", "signal", sig.String())
+			os.Exit(1)
+		}()
+		if healthSrv != nil {
+			healthSrv.Shutdown()
+		}
+		mafiaServer.Shutdown(cfg.ShutdownTimeout)
+		if gatewaySrv != nil {
+			gatewaySrv.Close()
+			gatewayGRPC.GracefulStop()
+		}
+		adminSrv.GracefulStop()
+		if metricsSrv != nil {
+			metricsSrv.Close()
+		}
+		srv.GracefulStop()
+	}()
+
+	slog.Info("server started", "address", cfg.Address, "admin_address", cfg.AdminAddress, "metrics_address", cfg.MetricsAddress, "gateway_address", cfg.GatewayAddress)
 	err = srv.Serve(lis)
 	if err != nil {
-		log.Fatalf("server failed: %v\n", err)
+		fatal("server failed", err)
+	}
+	err = shutdownTracing(context.Background())
+	if err != nil {
+		slog.Warn("failed to flush traces", "error", err)
 	}
+	slog.Info("server stopped")
+}
 
+func fatal(msg string, err error, args ...any) {
+	slog.Error(msg, append([]any{"error", err}, args...)...)
+	os.Exit(1)
 }
 
-func registerServer(address string) (*grpc.Server, net.Listener, error) {
-	lis, err := net.Listen("tcp", address)
+func registerServer(cfg *config.ServerConfig, mafiaServer *server.MafiaServer, signer *auth.Signer, creds credentials.TransportCredentials) (*grpc.Server, *health.Server, net.Listener, error) {
+	lis, err := net.Listen("tcp", cfg.Address)
 	if err != nil {
-		return nil, nil, fmt.Errorf("can't start listen")
+		return nil, nil, nil, fmt.Errorf("can't start listen")
 	}
 
-	grpcServer := grpc.NewServer(
-		grpc.ConnectionTimeout(DefaultTimeout),
+	grpcServer := grpc.NewServer(append(interceptors(cfg, signer),
+		grpc.Creds(creds),
+		grpc.ConnectionTimeout(cfg.KeepAlive.ConnectionTimeout),
 		grpc.KeepaliveParams(keepalive.ServerParameters{
-			MaxConnectionIdle: DefaultKeepAlive,
-			Time:              DefaultKeepAlive,
-			Timeout:           DefaultKeepAlive,
+			MaxConnectionIdle: cfg.KeepAlive.Interval,
+			Time:              cfg.KeepAlive.Interval,
+			Timeout:           cfg.KeepAlive.Interval,
+		}),
+	)...)
+
+	pb.RegisterMafiaServer(grpcServer, mafiaServer)
+
+	var healthServer *health.Server
+	if cfg.Health {
+		healthServer = health.NewServer()
+		healthServer.SetServingStatus(pb.Mafia_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+		healthpb.RegisterHealthServer(grpcServer, healthServer)
+	}
+	if cfg.Reflection {
+		reflection.Register(grpcServer)
+	}
+
+	return grpcServer, healthServer, lis, nil
+}
+
+func interceptors(cfg *config.ServerConfig, signer *auth.Signer) []grpc.ServerOption {
+	public := append([]string{}, publicMethods...)
+	if cfg.Health {
+		public = append(public, healthMethods...)
+	}
+	if cfg.Reflection {
+		public = append(public, reflectionMethods...)
+	}
+
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			otelgrpc.UnaryServerInterceptor(),
+			auth.UnaryServerInterceptor(signer, public...),
+		),
+		grpc.ChainStreamInterceptor(
+			otelgrpc.StreamServerInterceptor(),
+			auth.StreamServerInterceptor(signer, public...),
+		),
+	}
+}
+
+// registerGateway serves HTTP/JSON API backed by in-memory gRPC connection to Mafia service,
+// so gateway requests pass the same interceptors as gRPC clients. Gateway itself is served over TLS
+// with server certificate, web UI is served from the same address.
+func registerGateway(cfg *config.ServerConfig, mafiaServer *server.MafiaServer, signer *auth.Signer) (*http.Server, *grpc.Server, net.Listener, error) {
+	lis, err := net.Listen("tcp", cfg.GatewayAddress)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("can't start listen")
+	}
+
+	grpcLis := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer(interceptors(cfg, signer)...)
+	pb.RegisterMafiaServer(grpcServer, mafiaServer)
+	go func() {
+		_ = grpcServer.Serve(grpcLis)
+	}()
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return grpcLis.DialContext(ctx)
 		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
-	pb.RegisterMafiaServer(grpcServer, server.NewMafiaServer())
+	mux := http.NewServeMux()
+	mux.Handle("/api/", gateway.New(conn))
+	mux.Handle("/", web.Handler())
+	return &http.Server{Handler: mux}, grpcServer, lis, nil
+}
+
+func registerAdminServer(address string, mafiaServer *server.MafiaServer) (*grpc.Server, net.Listener, error) {
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't start listen")
+	}
+
+	grpcServer := grpc.NewServer()
+
+	pb.RegisterMafiaAdminServer(grpcServer, server.NewAdminServer(mafiaServer))
 
 	return grpcServer, lis, nil
 }
+
+func registerMetricsServer(address string, mafiaServer *server.MafiaServer) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", mafiaServer.MetricsHandler())
+	return &http.Server{Addr: address, Handler: mux}
+}
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"context"
+	"soa_hw_2/internal/pb"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type testStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestInterceptors(t *testing.T) {
+	signer := NewSigner([]byte("secret"), time.Hour)
+	accountID := uuid.New()
+	token, err := signer.Issue(Claims{Kind: KindAccount, AccountID: accountID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	foreign, err := NewSigner([]byte("other secret"), time.Hour).Issue(Claims{Kind: KindAccount, AccountID: accountID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		md       metadata.MD
+		expected codes.Code
+		claims   bool
+	}{
+		{"public method without token", "/mafia.Mafia/Login", nil, codes.OK, false},
+		{"public method ignores invalid token", "/mafia.Mafia/Login", pb.WithToken("invalid"), codes.OK, false},
+		{"no metadata", "/mafia.Mafia/Vote", nil, codes.Unauthenticated, false},
+		{"no token", "/mafia.Mafia/Vote", metadata.Pairs("other", "value"), codes.Unauthenticated, false},
+		{"two tokens", "/mafia.Mafia/Vote", metadata.Pairs("authorization", token, "authorization", token), codes.Unauthenticated, false},
+		{"malformed token", "/mafia.Mafia/Vote", pb.WithToken("invalid"), codes.Unauthenticated, false},
+		{"token of other server", "/mafia.Mafia/Vote", pb.WithToken(foreign), codes.Unauthenticated, false},
+		{"valid token", "/mafia.Mafia/Vote", pb.WithToken(token), codes.OK, true},
+	}
+
+	check := func(t *testing.T, ctx context.Context, hasClaims bool) {
+		claims, ok := ClaimsFromContext(ctx)
+		if ok != hasClaims {
+			t.Fatalf("expected claims in context: %t, got %t", hasClaims, ok)
+		}
+		if ok && (claims.Kind != KindAccount || claims.AccountID != accountID) {
+			t.Fatalf("unexpected claims %+v", claims)
+		}
+	}
+
+	for _, test := range tests {
+		ctx := context.Background()
+		if test.md != nil {
+			ctx = metadata.NewIncomingContext(ctx, test.md)
+		}
+
+		t.Run("unary "+test.name, func(t *testing.T) {
+			interceptor := UnaryServerInterceptor(signer, "/mafia.Mafia/Login")
+			info := &grpc.UnaryServerInfo{FullMethod: test.method}
+			_, err := interceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				check(t, ctx, test.claims)
+				return nil, nil
+			})
+			if code := status.Code(err); code != test.expected {
+				t.Fatalf("expected %s, got %s", test.expected, code)
+			}
+		})
+
+		t.Run("stream "+test.name, func(t *testing.T) {
+			interceptor := StreamServerInterceptor(signer, "/mafia.Mafia/Login")
+			info := &grpc.StreamServerInfo{FullMethod: test.method}
+			err := interceptor(nil, &testStream{ctx: ctx}, info, func(srv interface{}, stream grpc.ServerStream) error {
+				check(t, stream.Context(), test.claims)
+				return nil
+			})
+			if code := status.Code(err); code != test.expected {
+				t.Fatalf("expected %s, got %s", test.expected, code)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestValidate(t *testing.T) {
+	signer := NewSigner([]byte("secret"), time.Hour)
+	claims := Claims{
+		Kind:      KindPlayer,
+		AccountID: uuid.New(),
+		PlayerID:  uuid.New(),
+		SessionID: uuid.New(),
+	}
+
+	token, err := signer.Issue(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded, signature, _ := strings.Cut(token, ".")
+
+	other, err := NewSigner([]byte("other secret"), time.Hour).Issue(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired, err := NewSigner([]byte("secret"), -time.Minute).Issue(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged, err := NewSigner([]byte("secret"), time.Hour).Issue(Claims{Kind: KindAccount, AccountID: claims.AccountID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+
+	tests := []struct {
+		name     string
+		token    string
+		expected string
+	}{
+		{"valid token", token, ""},
+		{"no signature", encoded, "malformed token"},
+		{"signed with other secret", other, "invalid token signature"},
+		{"payload replaced", forgedPayload + "." + signature, "invalid token signature"},
+		{"corrupted signature", encoded + "." + signature + "x", "invalid token signature"},
+		{"expired token", expired, "token is expired"},
+		{"not base64 payload", "!!!." + signer.sign("!!!"), "malformed token"},
+		{"not json payload", "bm90IGpzb24." + signer.sign("bm90IGpzb24"), "malformed token"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			validated, err := signer.Validate(test.token)
+			if test.expected != "" {
+				if err == nil || err.Error() != test.expected {
+					t.Fatalf("expected %q, got %v", test.expected, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			validated.ExpiresAt = time.Time{}
+			if *validated != claims {
+				t.Fatalf("expected %+v, got %+v", claims, *validated)
+			}
+		})
+	}
+}
@@ -3,24 +3,55 @@ package client
 import (
 	"context"
 	"fmt"
-	"log"
 	"soa_hw_2/internal/pb"
+	"sync"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
+type EventStream interface {
+	Recv() (*pb.SessionEvent, error)
+}
+
 type Client struct {
-	ctx    context.Context
-	cli    pb.MafiaClient
-	stream pb.Mafia_StartSessionClient
-	events chan *pb.SessionEvent
+	ctx        context.Context
+	accountCtx context.Context
+	cli        pb.MafiaClient
+	stream     EventStream
+	events     chan *pb.SessionEvent
+	err        error
+
+	checked map[string]pb.Role
+	mutex   sync.Mutex
+}
+
+func Login(ctx context.Context, username string, password string, conn *grpc.ClientConn) (string, error) {
+	cli := pb.NewMafiaClient(conn)
+
+	resp, err := cli.Login(ctx, &pb.AccountRequest{Username: username, Password: password})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Token, nil
 }
 
-func NewClient(ctx context.Context, username string, conn *grpc.ClientConn) (*Client, error) {
+func Register(ctx context.Context, username string, password string, conn *grpc.ClientConn) (string, error) {
 	cli := pb.NewMafiaClient(conn)
 
-	stream, err := cli.StartSession(ctx, &pb.StartSessionRequest{Username: username})
+	resp, err := cli.Register(ctx, &pb.AccountRequest{Username: username, Password: password})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Token, nil
+}
+
+func NewClient(ctx context.Context, accountToken string, conn *grpc.ClientConn) (*Client, error) {
+	cli := pb.NewMafiaClient(conn)
+
+	stream, err := cli.StartSession(metadata.NewOutgoingContext(ctx, pb.WithToken(accountToken)), &pb.StartSessionRequest{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to start session: %s", err)
 	}
@@ -30,18 +61,101 @@ func NewClient(ctx context.Context, username string, conn *grpc.ClientConn) (*Cl
 		return nil, fmt.Errorf("failed to get metadata: %s", err)
 	}
 
-	sessionID, err := pb.FetchSessionID(md)
+	token, err := pb.FetchToken(md)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token: %s", err)
+	}
+
+	accountCtx := metadata.NewOutgoingContext(ctx, pb.WithToken(accountToken))
+	ctx = metadata.NewOutgoingContext(ctx, pb.WithToken(token))
+
+	return &Client{
+		ctx:        ctx,
+		accountCtx: accountCtx,
+		cli:        cli,
+		stream:     stream,
+		events:     make(chan *pb.SessionEvent),
+		checked:    make(map[string]pb.Role),
+	}, nil
+}
+
+func NewResumeClient(ctx context.Context, accountToken string, sessionID string, conn *grpc.ClientConn) (*Client, error) {
+	cli := pb.NewMafiaClient(conn)
+
+	stream, err := cli.Resume(metadata.NewOutgoingContext(ctx, pb.WithToken(accountToken)), &pb.ResumeRequest{SessionId: sessionID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to resume session: %s", err)
+	}
+
+	md, err := stream.Header()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata: %s", err)
+	}
+
+	token, err := pb.FetchToken(md)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get id: %s", err)
+		return nil, fmt.Errorf("failed to get token: %s", err)
 	}
 
-	ctx = metadata.NewOutgoingContext(ctx, pb.WithSessionID(sessionID))
+	accountCtx := metadata.NewOutgoingContext(ctx, pb.WithToken(accountToken))
+	ctx = metadata.NewOutgoingContext(ctx, pb.WithToken(token))
+
+	return &Client{
+		ctx:        ctx,
+		accountCtx: accountCtx,
+		cli:        cli,
+		stream:     stream,
+		events:     make(chan *pb.SessionEvent),
+		checked:    make(map[string]pb.Role),
+	}, nil
+}
+
+func NewSpectatorClient(ctx context.Context, sessionID string, conn *grpc.ClientConn) (*Client, error) {
+	cli := pb.NewMafiaClient(conn)
+
+	stream, err := cli.Spectate(ctx, &pb.SpectateRequest{SessionId: sessionID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to spectate session: %s", err)
+	}
 
 	return &Client{
-		ctx:    ctx,
-		cli:    cli,
-		stream: stream,
-		events: make(chan *pb.SessionEvent),
+		ctx:        ctx,
+		accountCtx: ctx,
+		cli:        cli,
+		stream:     stream,
+		events:     make(chan *pb.SessionEvent),
+		checked:    make(map[string]pb.Role),
+	}, nil
+}
+
+func NewModeratorClient(ctx context.Context, accountToken string, sessionID string, conn *grpc.ClientConn) (*Client, error) {
+	cli := pb.NewMafiaClient(conn)
+
+	accountCtx := metadata.NewOutgoingContext(ctx, pb.WithToken(accountToken))
+	stream, err := cli.Moderate(accountCtx, &pb.ModerateRequest{SessionId: sessionID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to moderate session: %s", err)
+	}
+
+	md, err := stream.Header()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata: %s", err)
+	}
+
+	token, err := pb.FetchToken(md)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get moderator token: %s", err)
+	}
+
+	ctx = metadata.NewOutgoingContext(ctx, pb.WithToken(token))
+
+	return &Client{
+		ctx:        ctx,
+		accountCtx: accountCtx,
+		cli:        cli,
+		stream:     stream,
+		events:     make(chan *pb.SessionEvent),
+		checked:    make(map[string]pb.Role),
 	}, nil
 }
 
@@ -49,11 +163,13 @@ func (c *Client) Events() <-chan *pb.SessionEvent {
 	return c.events
 }
 
+// ForwardEvents closes Events channel when stream is closed, Err returns the reason after that.
 func (c *Client) ForwardEvents() {
 	for {
 		event, err := c.stream.Recv()
 		if err != nil {
-			log.Fatalf("\n\nServer closed\n")
+			c.err = err
+			close(c.events)
 			return
 		}
 
@@ -61,21 +177,121 @@ func (c *Client) ForwardEvents() {
 	}
 }
 
+func (c *Client) Err() error {
+	return c.err
+}
+
 func (c *Client) Vote(username string) error {
 	_, err := c.cli.Vote(c.ctx, &pb.VoteRequest{Username: username})
 
 	return err
 }
 
-func (c *Client) Check(username string) (string, error) {
+func (c *Client) Check(username string) (*pb.CheckResponse, error) {
 	resp, err := c.cli.Check(c.ctx, &pb.CheckRequest{Username: username})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return RoleToString(resp.Role), err
+	c.mutex.Lock()
+	c.checked[username] = resp.Role
+	c.mutex.Unlock()
+
+	return resp, nil
+}
+
+// CheckedRole returns role of player found by sheriff checks of this client.
+func (c *Client) CheckedRole(username string) pb.Role {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.checked[username]
 }
 
 func (c *Client) GetState() (*pb.SessionState, error) {
 	return c.cli.GetSessionState(c.ctx, &pb.Empty{})
 }
+
+func (c *Client) SetReady(ready bool) error {
+	_, err := c.cli.SetReady(c.ctx, &pb.ReadyRequest{Ready: ready})
+
+	return err
+}
+
+func (c *Client) StartGame() error {
+	_, err := c.cli.StartGame(c.ctx, &pb.Empty{})
+
+	return err
+}
+
+func (c *Client) InviteModerator(username string) error {
+	_, err := c.cli.InviteModerator(c.ctx, &pb.ModeratorRequest{Username: username})
+
+	return err
+}
+
+func (c *Client) SendMessage(text string) error {
+	_, err := c.cli.SendMessage(c.ctx, &pb.ChatRequest{Text: text})
+
+	return err
+}
+
+func (c *Client) PauseTimer() error {
+	_, err := c.cli.PauseTimer(c.ctx, &pb.Empty{})
+
+	return err
+}
+
+func (c *Client) ResumeTimer() error {
+	_, err := c.cli.ResumeTimer(c.ctx, &pb.Empty{})
+
+	return err
+}
+
+func (c *Client) AdvancePhase() error {
+	_, err := c.cli.AdvancePhase(c.ctx, &pb.Empty{})
+
+	return err
+}
+
+func (c *Client) KillPlayer(username string) error {
+	_, err := c.cli.KillPlayer(c.ctx, &pb.ModeratorRequest{Username: username})
+
+	return err
+}
+
+func (c *Client) RevivePlayer(username string) error {
+	_, err := c.cli.RevivePlayer(c.ctx, &pb.ModeratorRequest{Username: username})
+
+	return err
+}
+
+func (c *Client) KickPlayer(username string) error {
+	_, err := c.cli.KickPlayer(c.ctx, &pb.ModeratorRequest{Username: username})
+
+	return err
+}
+
+func (c *Client) ListGames() ([]*pb.GameSummary, error) {
+	resp, err := c.cli.ListGames(c.accountCtx, &pb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Games, nil
+}
+
+func (c *Client) GetGame(gameID string) (*pb.GameInfo, error) {
+	return c.cli.GetGame(c.accountCtx, &pb.GameRequest{GameId: gameID})
+}
+
+func (c *Client) GetGameTimeline(gameID string) (*pb.GameTimeline, error) {
+	return c.cli.GetGameTimeline(c.accountCtx, &pb.GameRequest{GameId: gameID})
+}
+
+func (c *Client) GetPlayerStats(username string) (*pb.PlayerStats, error) {
+	return c.cli.GetPlayerStats(c.accountCtx, &pb.PlayerStatsRequest{Username: username})
+}
+
+func (c *Client) GetLeaderboard() (*pb.Leaderboard, error) {
+	return c.cli.GetLeaderboard(c.accountCtx, &pb.LeaderboardRequest{})
+}
@@ -0,0 +1,178 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Command describes one input command, Args is the usage of its arguments where `{arg}` is required,
+// `[arg]` is optional and `{arg...}` takes the rest of the line. Run prints result for a human and
+// Call returns it as is for scripts, local commands like help and replay have no Call.
+type Command struct {
+	Name      string
+	Aliases   []string
+	Args      string
+	Help      string
+	Moderator bool
+	Run       func(h *Handler, args []string)
+	Call      func(c *Client, args []string) (proto.Message, error)
+}
+
+type Commands struct {
+	list  []*Command
+	names map[string]*Command
+}
+
+func NewCommands(commands ...*Command) *Commands {
+	c := &Commands{names: make(map[string]*Command)}
+	for _, command := range commands {
+		c.list = append(c.list, command)
+		c.names[command.Name] = command
+		for _, alias := range command.Aliases {
+			c.names[alias] = command
+		}
+	}
+	return c
+}
+
+func (c *Commands) Find(name string) (*Command, bool) {
+	command, ok := c.names[strings.ToLower(name)]
+	return command, ok
+}
+
+// Parse finds command by its name or alias and checks number of arguments.
+func (c *Commands) Parse(input string) (*Command, []string, error) {
+	name, rest, _ := strings.Cut(strings.TrimSpace(input), " ")
+	if name == "" {
+		return nil, nil, fmt.Errorf("empty command, run help to list commands")
+	}
+
+	command, ok := c.Find(name)
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown command %q, run help to list commands", name)
+	}
+
+	args, err := command.parseArgs(strings.TrimSpace(rest))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %s, usage: %s", command.Name, err, command.Usage())
+	}
+	return command, args, nil
+}
+
+func (c *Command) parseArgs(rest string) ([]string, error) {
+	specs := strings.Fields(c.Args)
+	required := 0
+	for _, spec := range specs {
+		if strings.HasPrefix(spec, "{") {
+			required++
+		}
+	}
+
+	args := []string{}
+	for i, spec := range specs {
+		rest = strings.TrimLeft(rest, " ")
+		if rest == "" {
+			break
+		}
+		if i == len(specs)-1 && strings.HasSuffix(spec, "...}") {
+			args = append(args, rest)
+			rest = ""
+			break
+		}
+		var arg string
+		arg, rest, _ = strings.Cut(rest, " ")
+		args = append(args, arg)
+	}
+
+	if len(args) < required {
+		missing := strings.Trim(specs[len(args)], "{}.")
+		return nil, fmt.Errorf("missing %s", missing)
+	}
+	if extra := strings.Fields(rest); len(extra) > 0 {
+		return nil, fmt.Errorf("unexpected argument %q", extra[0])
+	}
+	return args, nil
+}
+
+func (c *Command) Usage() string {
+	if c.Args == "" {
+		return c.Name
+	}
+	return c.Name + " " + c.Args
+}
+
+// CompletesUsername is true for commands whose first argument is a player.
+func (c *Command) CompletesUsername() bool {
+	return strings.HasPrefix(c.Args, "{username}") || strings.HasPrefix(c.Args, "[username]")
+}
+
+func (c *Command) Describe() string {
+	str := c.Usage() + " - " + c.Help
+	if len(c.Aliases) > 0 {
+		str += fmt.Sprintf(" (aliases: %s)", strings.Join(c.Aliases, ", "))
+	}
+	return str
+}
+
+func (c *Commands) Help() string {
+	str := "usage:\n"
+	for _, command := range c.list {
+		if !command.Moderator {
+			str += "\n    " + command.Describe() + "\n"
+		}
+	}
+	str += "\n  moderator commands:\n"
+	for _, command := range c.list {
+		if command.Moderator {
+			str += "\n    " + command.Describe() + "\n"
+		}
+	}
+	return str
+}
+
+// Complete returns possible completions of the whole input line: command names for the first word
+// and usernames for the first argument of commands that take a player.
+func (c *Commands) Complete(input string, usernames []string) []string {
+	name, rest, hasArgs := strings.Cut(strings.TrimLeft(input, " "), " ")
+	if !hasArgs {
+		candidates := []string{}
+		for _, command := range c.list {
+			if strings.HasPrefix(command.Name, strings.ToLower(name)) {
+				candidates = append(candidates, command.Name+" ")
+			}
+		}
+		sort.Strings(candidates)
+		return candidates
+	}
+
+	command, ok := c.Find(name)
+	if !ok || !command.CompletesUsername() || strings.Contains(strings.TrimLeft(rest, " "), " ") {
+		return nil
+	}
+	prefix := strings.TrimLeft(rest, " ")
+	candidates := []string{}
+	for _, username := range usernames {
+		if strings.HasPrefix(username, prefix) {
+			candidates = append(candidates, name+" "+username)
+		}
+	}
+	sort.Strings(candidates)
+	return candidates
+}
+
+// CommonPrefix returns the longest prefix of all candidates to extend input with on ambiguous completion.
+func CommonPrefix(candidates []string) string {
+	if len(candidates) == 0 {
+		return ""
+	}
+	prefix := candidates[0]
+	for _, candidate := range candidates[1:] {
+		for !strings.HasPrefix(candidate, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
@@ -0,0 +1,101 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		command  string
+		args     []string
+		expected string
+	}{
+		{"command with argument", "vote bob", "vote", []string{"bob"}, ""},
+		{"alias in other case with spaces", "  V   bob  ", "vote", []string{"bob"}, ""},
+		{"symbol alias", "?", "help", []string{}, ""},
+		{"rest of the line", "say hello   world", "say", []string{"hello   world"}, ""},
+		{"optional argument omitted", "replay id", "replay", []string{"id"}, ""},
+		{"optional argument", "replay id 2", "replay", []string{"id", "2"}, ""},
+		{"empty input", "   ", "", nil, "empty command, run help to list commands"},
+		{"unknown command", "dance now", "", nil, `unknown command "dance", run help to list commands`},
+		{"missing argument", "vote", "", nil, "vote: missing username, usage: vote {username}"},
+		{"missing rest of the line", "chat ", "", nil, "say: missing message, usage: say {message...}"},
+		{"unexpected argument", "ready now", "", nil, `ready: unexpected argument "now", usage: ready`},
+		{"too many arguments", "replay id 2 3", "", nil, `replay: unexpected argument "3", usage: replay {id} [speed]`},
+	}
+
+	commands := newCommands()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			command, args, err := commands.Parse(test.input)
+			if test.expected != "" {
+				if err == nil || err.Error() != test.expected {
+					t.Fatalf("expected %q, got %v", test.expected, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if command.Name != test.command || !reflect.DeepEqual(args, test.args) {
+				t.Fatalf("expected %s %q, got %s %q", test.command, test.args, command.Name, args)
+			}
+		})
+	}
+}
+
+func TestComplete(t *testing.T) {
+	usernames := []string{"bob", "alice", "alex"}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{"command names", "st", []string{"start ", "stats "}},
+		{"command name in other case", "LEA", []string{"leaderboard "}},
+		{"unknown command name", "dance", []string{}},
+		{"all usernames", "vote ", []string{"vote alex", "vote alice", "vote bob"}},
+		{"username prefix", "kill al", []string{"kill alex", "kill alice"}},
+		{"username of alias", "c b", []string{"c bob"}},
+		{"optional username", "stats a", []string{"stats alex", "stats alice"}},
+		{"command without username", "say a", nil},
+		{"second argument", "vote bob a", nil},
+		{"unknown command", "dance a", nil},
+	}
+
+	commands := newCommands()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			candidates := commands.Complete(test.input, usernames)
+			if !reflect.DeepEqual(candidates, test.expected) {
+				t.Fatalf("expected %q, got %q", test.expected, candidates)
+			}
+		})
+	}
+}
+
+func TestCommonPrefix(t *testing.T) {
+	tests := []struct {
+		name       string
+		candidates []string
+		expected   string
+	}{
+		{"no candidates", nil, ""},
+		{"one candidate", []string{"vote bob"}, "vote bob"},
+		{"shared prefix", []string{"kill alex", "kill alice"}, "kill al"},
+		{"no shared prefix", []string{"start ", "game "}, ""},
+		{"candidate is prefix of other", []string{"game ", "games "}, "game"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if prefix := CommonPrefix(test.candidates); prefix != test.expected {
+				t.Fatalf("expected %q, got %q", test.expected, prefix)
+			}
+		})
+	}
+}
@@ -2,21 +2,35 @@ package client
 
 import (
 	"fmt"
+	"log"
 	"soa_hw_2/internal/pb"
 	"strings"
+
+	"google.golang.org/protobuf/proto"
 )
 
 type Handler struct {
 	client    *Client
 	messenger *Messenger
-	state     int
+	commands  *Commands
+	phase     pb.Phase
+	replay    bool
 }
 
 func NewHandler(client *Client, messenger *Messenger) *Handler {
 	return &Handler{
 		client:    client,
 		messenger: messenger,
-		state:     0,
+		commands:  newCommands(),
+		phase:     pb.Phase_UNKNOWN_PHASE,
+	}
+}
+
+func NewReplayHandler(messenger *Messenger) *Handler {
+	return &Handler{
+		messenger: messenger,
+		phase:     pb.Phase_UNKNOWN_PHASE,
+		replay:    true,
 	}
 }
 
@@ -26,22 +40,140 @@ func (h *Handler) Start() {
 }
 
 func (h *Handler) handleInput() {
-	for {
-		input := <-h.messenger.input
-		switch {
-		case strings.HasPrefix(input, "vote"):
-			h.vote(input[len("vote")+1:])
-		case strings.HasPrefix(input, "check"):
-			h.check(input[len("check")+1:])
-		case strings.HasPrefix(input, "get_state"):
-			h.getState()
-
-		default:
-			h.sendOutput("invalid input received")
-		}
+	for input := range h.messenger.input {
+		h.Execute(input)
 	}
 }
 
+func (h *Handler) Execute(input string) {
+	if strings.TrimSpace(input) == "" {
+		return
+	}
+	command, args, err := h.commands.Parse(input)
+	if err != nil {
+		h.sendOutput(err.Error())
+		return
+	}
+	command.Run(h, args)
+}
+
+func (h *Handler) Commands() *Commands {
+	return h.commands
+}
+
+func newCommands() *Commands {
+	return NewCommands(
+		&Command{Name: "get_state", Aliases: []string{"state"}, Help: "get current state",
+			Run: func(h *Handler, args []string) { h.getState() },
+			Call: func(c *Client, args []string) (proto.Message, error) {
+				return c.GetState()
+			}},
+		&Command{Name: "ready", Help: "mark yourself as ready to play (game starts when lobby is full and all are ready)",
+			Run: func(h *Handler, args []string) { h.setReady(true) },
+			Call: func(c *Client, args []string) (proto.Message, error) {
+				return &pb.Empty{}, c.SetReady(true)
+			}},
+		&Command{Name: "unready", Help: "cancel readiness",
+			Run: func(h *Handler, args []string) { h.setReady(false) },
+			Call: func(c *Client, args []string) (proto.Message, error) {
+				return &pb.Empty{}, c.SetReady(false)
+			}},
+		&Command{Name: "start", Help: "start the game before lobby is full (allowed only for host when all players are ready)",
+			Run: func(h *Handler, args []string) { h.startGame() },
+			Call: func(c *Client, args []string) (proto.Message, error) {
+				return &pb.Empty{}, c.StartGame()
+			}},
+		&Command{Name: "invite", Args: "{username}", Help: "allow account to moderate the session (allowed only for host)",
+			Run: func(h *Handler, args []string) { h.inviteModerator(args[0]) },
+			Call: func(c *Client, args []string) (proto.Message, error) {
+				return &pb.Empty{}, c.InviteModerator(args[0])
+			}},
+		&Command{Name: "vote", Aliases: []string{"v"}, Args: "{username}", Help: "vote for jailing or killing player (jailing allowed only during day, killing - during night for mafia)",
+			Run: func(h *Handler, args []string) { h.vote(args[0]) },
+			Call: func(c *Client, args []string) (proto.Message, error) {
+				return &pb.Empty{}, c.Vote(args[0])
+			}},
+		&Command{Name: "check", Aliases: []string{"c"}, Args: "{username}", Help: "check role of player (allowed only for sheriff during night)",
+			Run: func(h *Handler, args []string) { h.check(args[0]) },
+			Call: func(c *Client, args []string) (proto.Message, error) {
+				return c.Check(args[0])
+			}},
+		&Command{Name: "say", Aliases: []string{"chat"}, Args: "{message...}", Help: "send message to chat (not allowed during night, dead players chat only with each other)",
+			Run: func(h *Handler, args []string) { h.sendMessage(args[0]) },
+			Call: func(c *Client, args []string) (proto.Message, error) {
+				return &pb.Empty{}, c.SendMessage(args[0])
+			}},
+		&Command{Name: "games", Help: "list your past games",
+			Run: func(h *Handler, args []string) { h.listGames() },
+			Call: func(c *Client, args []string) (proto.Message, error) {
+				games, err := c.ListGames()
+				return &pb.GameList{Games: games}, err
+			}},
+		&Command{Name: "game", Args: "{id}", Help: "show players, votes and checks of your past game",
+			Run: func(h *Handler, args []string) { h.getGame(args[0]) },
+			Call: func(c *Client, args []string) (proto.Message, error) {
+				return c.GetGame(args[0])
+			}},
+		&Command{Name: "stats", Args: "[username]", Help: "show your statistics or statistics of another player",
+			Run: func(h *Handler, args []string) { h.getStats(strings.Join(args, "")) },
+			Call: func(c *Client, args []string) (proto.Message, error) {
+				return c.GetPlayerStats(strings.Join(args, ""))
+			}},
+		&Command{Name: "leaderboard", Aliases: []string{"top"}, Help: "show players with the most wins",
+			Run: func(h *Handler, args []string) { h.getLeaderboard() },
+			Call: func(c *Client, args []string) (proto.Message, error) {
+				return c.GetLeaderboard()
+			}},
+		&Command{Name: "replay", Args: "{id} [speed]", Help: "play back your past game with roles of all players (speed 2 is twice faster)",
+			Run: func(h *Handler, args []string) { h.startReplay(args) }},
+		&Command{Name: "help", Aliases: []string{"h", "?"}, Args: "[command]", Help: "list commands or show help of one command",
+			Run: func(h *Handler, args []string) { h.help(args) }},
+		&Command{Name: "pause", Moderator: true, Help: "pause the phase timer",
+			Run: func(h *Handler, args []string) { h.moderate("pause", h.client.PauseTimer) },
+			Call: func(c *Client, args []string) (proto.Message, error) {
+				return &pb.Empty{}, c.PauseTimer()
+			}},
+		&Command{Name: "resume", Moderator: true, Help: "resume the phase timer",
+			Run: func(h *Handler, args []string) { h.moderate("resume", h.client.ResumeTimer) },
+			Call: func(c *Client, args []string) (proto.Message, error) {
+				return &pb.Empty{}, c.ResumeTimer()
+			}},
+		&Command{Name: "advance", Moderator: true, Help: "end current phase with votes made so far",
+			Run: func(h *Handler, args []string) { h.moderate("advance", h.client.AdvancePhase) },
+			Call: func(c *Client, args []string) (proto.Message, error) {
+				return &pb.Empty{}, c.AdvancePhase()
+			}},
+		&Command{Name: "kill", Moderator: true, Args: "{username}", Help: "kill player",
+			Run: func(h *Handler, args []string) { h.moderatePlayer("kill", args[0], h.client.KillPlayer) },
+			Call: func(c *Client, args []string) (proto.Message, error) {
+				return &pb.Empty{}, c.KillPlayer(args[0])
+			}},
+		&Command{Name: "revive", Moderator: true, Args: "{username}", Help: "revive player",
+			Run: func(h *Handler, args []string) { h.moderatePlayer("revive", args[0], h.client.RevivePlayer) },
+			Call: func(c *Client, args []string) (proto.Message, error) {
+				return &pb.Empty{}, c.RevivePlayer(args[0])
+			}},
+		&Command{Name: "kick", Moderator: true, Args: "{username}", Help: "remove player from the game",
+			Run: func(h *Handler, args []string) { h.moderatePlayer("kick", args[0], h.client.KickPlayer) },
+			Call: func(c *Client, args []string) (proto.Message, error) {
+				return &pb.Empty{}, c.KickPlayer(args[0])
+			}},
+	)
+}
+
+func (h *Handler) help(args []string) {
+	if len(args) == 0 {
+		h.handleHelp()
+		return
+	}
+	command, ok := h.commands.Find(args[0])
+	if !ok {
+		h.sendOutput(fmt.Sprintf("unknown command %q, run help to list commands", args[0]))
+		return
+	}
+	h.sendOutput(command.Describe())
+}
+
 func (h *Handler) vote(username string) {
 	err := h.client.Vote(username)
 	if err != nil {
@@ -54,7 +186,51 @@ func (h *Handler) check(username string) {
 	if err != nil {
 		h.sendOutput(fmt.Sprintf("check error: %s", err))
 	} else {
-		h.sendOutput(fmt.Sprintf("username %s is %s", username, result))
+		h.sendOutput(fmt.Sprintf("username %s is %s", username, RoleToString(result.Role)))
+	}
+}
+
+func (h *Handler) setReady(ready bool) {
+	err := h.client.SetReady(ready)
+	if err != nil {
+		h.sendOutput(fmt.Sprintf("ready error: %s", err))
+	}
+}
+
+func (h *Handler) startGame() {
+	err := h.client.StartGame()
+	if err != nil {
+		h.sendOutput(fmt.Sprintf("start error: %s", err))
+	}
+}
+
+func (h *Handler) inviteModerator(username string) {
+	err := h.client.InviteModerator(username)
+	if err != nil {
+		h.sendOutput(fmt.Sprintf("invite error: %s", err))
+		return
+	}
+	h.sendOutput(fmt.Sprintf("%s is invited to moderate the session", username))
+}
+
+func (h *Handler) sendMessage(text string) {
+	err := h.client.SendMessage(text)
+	if err != nil {
+		h.sendOutput(fmt.Sprintf("chat error: %s", err))
+	}
+}
+
+func (h *Handler) moderate(command string, action func() error) {
+	err := action()
+	if err != nil {
+		h.sendOutput(fmt.Sprintf("%s error: %s", command, err))
+	}
+}
+
+func (h *Handler) moderatePlayer(command string, username string, action func(string) error) {
+	err := action(username)
+	if err != nil {
+		h.sendOutput(fmt.Sprintf("%s error: %s", command, err))
 	}
 }
 
@@ -72,25 +248,108 @@ func (h *Handler) getState() {
 	}
 }
 
+func (h *Handler) listGames() {
+	games, err := h.client.ListGames()
+	if err != nil {
+		h.sendOutput(fmt.Sprintf("games error: %s", err))
+		return
+	}
+
+	str := fmt.Sprintf("past games: %d", len(games))
+	for _, game := range games {
+		str += "\n" + GameSummaryToString(game)
+	}
+	h.sendOutput(str)
+}
+
+func (h *Handler) getGame(gameID string) {
+	game, err := h.client.GetGame(gameID)
+	if err != nil {
+		h.sendOutput(fmt.Sprintf("game error: %s", err))
+		return
+	}
+
+	h.sendOutput(GameInfoToString(game))
+}
+
+func (h *Handler) getStats(username string) {
+	stats, err := h.client.GetPlayerStats(username)
+	if err != nil {
+		h.sendOutput(fmt.Sprintf("stats error: %s", err))
+		return
+	}
+
+	h.sendOutput(StatsToString(stats))
+}
+
+func (h *Handler) getLeaderboard() {
+	leaderboard, err := h.client.GetLeaderboard()
+	if err != nil {
+		h.sendOutput(fmt.Sprintf("leaderboard error: %s", err))
+		return
+	}
+
+	str := "leaderboard:"
+	for i, entry := range leaderboard.Entries {
+		str += fmt.Sprintf("\n%d. %s, wins: %d of %d games (%.0f%%), rating: %.0f", i+1, entry.Username, entry.Wins, entry.Games, entry.WinRate*100, entry.Rating)
+	}
+	h.sendOutput(str)
+}
+
+func (h *Handler) startReplay(args []string) {
+	gameID, speed, err := ParseReplayArgs(args)
+	if err != nil {
+		h.sendOutput(fmt.Sprintf("replay error: %s", err))
+		return
+	}
+
+	timeline, err := h.client.GetGameTimeline(gameID)
+	if err != nil {
+		h.sendOutput(fmt.Sprintf("replay error: %s", err))
+		return
+	}
+
+	replayHandler := NewReplayHandler(h.messenger)
+	go func() {
+		Replay(h.client.ctx, timeline, speed, replayHandler.HandleEvent)
+		h.sendOutput(fmt.Sprintf("Replay of game %s is over", gameID))
+	}()
+}
+
 func (h *Handler) handleEvents() {
 	h.handleHelp()
-	for {
-		event := <-h.client.events
-
-		switch event.EventInfo.(type) {
-		case *pb.SessionEvent_JoinInfo:
-			h.handleJoin(event.GetJoinInfo())
-		case *pb.SessionEvent_StartInfo:
-			h.handleStart(event.GetStartInfo())
-		case *pb.SessionEvent_VoteInfo_:
-			h.handleVote(event.GetVoteInfo())
-		case *pb.SessionEvent_LeftInfo:
-			h.handleLeft(event.GetLeftInfo())
-		case *pb.SessionEvent_FinishInfo:
-			h.handleFinish(event.GetFinishInfo())
-		default:
-			h.sendOutput("invalid event received")
-		}
+	for event := range h.client.events {
+		h.HandleEvent(event)
+	}
+	log.Fatalf("\n\nServer closed\n")
+}
+
+func (h *Handler) HandleEvent(event *pb.SessionEvent) {
+	switch event.EventInfo.(type) {
+	case *pb.SessionEvent_JoinInfo:
+		h.handleJoin(event.GetJoinInfo())
+	case *pb.SessionEvent_StartInfo:
+		h.handleStart(event.GetStartInfo())
+	case *pb.SessionEvent_VoteInfo_:
+		h.handleVote(event.GetVoteInfo())
+	case *pb.SessionEvent_LeftInfo:
+		h.handleLeft(event.GetLeftInfo())
+	case *pb.SessionEvent_FinishInfo:
+		h.handleFinish(event.GetFinishInfo())
+	case *pb.SessionEvent_LobbyUpdate:
+		h.handleLobby(event.GetLobbyUpdate())
+	case *pb.SessionEvent_PhaseInfo_:
+		h.handlePhase(event.GetPhaseInfo())
+	case *pb.SessionEvent_ChatInfo_:
+		h.handleChat(event.GetChatInfo())
+	case *pb.SessionEvent_NightActionInfo_:
+		h.handleNightAction(event.GetNightActionInfo())
+	case *pb.SessionEvent_ModeratorActionInfo_:
+		h.handleModeratorAction(event.GetModeratorActionInfo())
+	case *pb.SessionEvent_ShutdownInfo:
+		h.handleShutdown(event.GetShutdownInfo())
+	default:
+		h.sendOutput("invalid event received")
 	}
 }
 
@@ -103,13 +362,27 @@ func (h *Handler) handleJoin(info *pb.SessionEvent_PlayerJoinInfo) {
 }
 
 func (h *Handler) handleStart(info *pb.SessionEvent_SessionStartInfo) {
-	str := fmt.Sprintf("Your role: %s", RoleToString(info.Role))
+	str := fmt.Sprintf("Game %s started", info.SessionId)
+	if h.replay {
+		str += "\nReplay with roles of all players"
+	} else if info.Role == pb.Role_UNKNOWN_ROLE {
+		str += "\nYou are spectating"
+	} else {
+		str += fmt.Sprintf("\nYour role: %s", RoleToString(info.Role))
+	}
 	str += "\nplayers:\n"
 	for _, player := range info.Players {
 		str += "\n" + PlayerToString(player) + "\n"
 	}
 	h.sendOutput(str)
-	h.updateState()
+}
+
+func (h *Handler) handleLobby(info *pb.SessionEvent_LobbyUpdateInfo) {
+	str := fmt.Sprintf("Lobby: %d/%d players, host: %s, at least %d needed to start", len(info.Players), info.MaxPlayers, info.Host, info.MinPlayers)
+	for _, player := range info.Players {
+		str += "\n" + LobbyPlayerToString(player)
+	}
+	h.sendOutput(str)
 }
 
 func (h *Handler) handleLeft(info *pb.SessionEvent_PlayerLeftInfo) {
@@ -118,41 +391,84 @@ func (h *Handler) handleLeft(info *pb.SessionEvent_PlayerLeftInfo) {
 
 func (h *Handler) handleVote(info *pb.SessionEvent_VoteInfo) {
 
-	if h.state%2 == 1 {
+	if h.phase == pb.Phase_NIGHT {
 		h.sendOutput(fmt.Sprintf("Player %s was killed by mafia", info.Username))
 	} else {
 		h.sendOutput(fmt.Sprintf("Player %s was voted", info.Username))
 	}
-
-	h.updateState()
 }
 
-func (h *Handler) updateState() {
-	h.state++
-	if h.state%2 == 1 {
-		h.sendOutput(fmt.Sprintf("%d night: mafia should vote and sheriff should check", h.state/2+1))
+func (h *Handler) handlePhase(info *pb.SessionEvent_PhaseInfo) {
+	h.phase = info.Phase
+	str := ""
+	if info.Phase == pb.Phase_NIGHT {
+		str = fmt.Sprintf("%d night: mafia should vote and sheriff should check", info.Number)
 	} else {
-		h.sendOutput(fmt.Sprintf("%d day: all should vote", h.state/2+1))
+		str = fmt.Sprintf("%d day: all should vote", info.Number)
+	}
+	if info.Paused {
+		str += fmt.Sprintf(" (timer is paused, %d seconds left)", info.SecondsLeft)
+	} else if info.SecondsLeft > 0 {
+		str += fmt.Sprintf(" (%d seconds left)", info.SecondsLeft)
 	}
+	h.sendOutput(str)
+}
 
+func (h *Handler) handleModeratorAction(info *pb.SessionEvent_ModeratorActionInfo) {
+	switch info.Action {
+	case pb.ModeratorAction_PAUSE_TIMER:
+		h.sendOutput("Moderator paused the timer")
+	case pb.ModeratorAction_RESUME_TIMER:
+		h.sendOutput("Moderator resumed the timer")
+	case pb.ModeratorAction_ADVANCE_PHASE:
+		h.sendOutput("Moderator ended the phase")
+	case pb.ModeratorAction_KILL_PLAYER:
+		h.sendOutput(fmt.Sprintf("Player %s was killed by moderator", info.Username))
+	case pb.ModeratorAction_REVIVE_PLAYER:
+		h.sendOutput(fmt.Sprintf("Player %s was revived by moderator", info.Username))
+	case pb.ModeratorAction_KICK_PLAYER:
+		h.sendOutput(fmt.Sprintf("Player %s was kicked from the game", info.Username))
+	}
 }
 
-func (h *Handler) handleHelp() {
-	str := `
-usage:
-        
-    get_state - get current state
+func (h *Handler) handleShutdown(info *pb.SessionEvent_ServerShutdownInfo) {
+	if info.SecondsLeft == 0 {
+		h.sendOutput("Server is shutting down, the game is terminated")
+		return
+	}
+	if info.Resumable {
+		h.sendOutput(fmt.Sprintf("Server is shutting down, the game will be saved if it doesn't end in %d seconds, rejoin it with -resume after restart", info.SecondsLeft))
+		return
+	}
+	h.sendOutput(fmt.Sprintf("Server is shutting down, the game will be terminated if it doesn't end in %d seconds", info.SecondsLeft))
+}
 
-    check - check role of player (allowed only for sheriff during night)
+func (h *Handler) handleChat(info *pb.SessionEvent_ChatInfo) {
+	if info.Channel == pb.ChatChannel_DEAD_CHANNEL {
+		h.sendOutput(fmt.Sprintf("[dead %s]: %s", info.Username, info.Text))
+	} else {
+		h.sendOutput(fmt.Sprintf("[%s]: %s", info.Username, info.Text))
+	}
+}
 
-    vote - vote for jailing or killing player (jailing allowed only during day, killing - during night for mafia)
+func (h *Handler) handleNightAction(info *pb.SessionEvent_NightActionInfo) {
+	switch info.Action {
+	case pb.NightAction_MAFIA_VOTE:
+		h.sendOutput(fmt.Sprintf("Mafia %s voted to kill %s", info.Username, info.Target))
+	case pb.NightAction_SHERIFF_CHECK:
+		h.sendOutput(fmt.Sprintf("Sheriff %s checked %s: %s", info.Username, info.Target, RoleToString(info.TargetRole)))
+	}
+}
 
-`
-	h.sendOutput(str)
+func (h *Handler) handleHelp() {
+	h.sendOutput(h.commands.Help())
 }
 
 func (h *Handler) handleFinish(info *pb.SessionEvent_SessionFinishInfo) {
 	str := fmt.Sprintf("Team %s winned", TeamToString(info.Winners))
+	if info.Winners == pb.Team_UNKNOWN_TEAM {
+		str = "Game was terminated"
+	}
 	str += "\nplayers:\n"
 	for _, player := range info.Players {
 		str += "\n" + PlayerToString(player) + "\n"
@@ -188,3 +504,10 @@ func RoleToString(role pb.Role) string {
 func PlayerToString(player *pb.Player) string {
 	return fmt.Sprintf("player %s, role: %s, alive: %t", player.Username, RoleToString(player.Role), player.Liveness)
 }
+
+func LobbyPlayerToString(player *pb.SessionEvent_LobbyPlayer) string {
+	if player.Ready {
+		return fmt.Sprintf("player %s, ready", player.Username)
+	}
+	return fmt.Sprintf("player %s, not ready", player.Username)
+}
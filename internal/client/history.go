@@ -0,0 +1,70 @@
+package client
+
+import (
+	"fmt"
+	"soa_hw_2/internal/pb"
+	"time"
+)
+
+func PhaseToString(phase pb.Phase, number int32) string {
+	if phase == pb.Phase_NIGHT {
+		return fmt.Sprintf("%d night", number)
+	}
+	return fmt.Sprintf("%d day", number)
+}
+
+func GameSummaryToString(game *pb.GameSummary) string {
+	result := "lost"
+	if game.Winner == pb.Team_UNKNOWN_TEAM {
+		result = "terminated"
+	} else if game.Won {
+		result = "won"
+	}
+	return fmt.Sprintf("game %s at %s, %d players, role: %s, %s, survived: %t",
+		game.GameId, time.Unix(game.FinishedAt, 0).Format(time.DateTime), game.PlayersCount,
+		RoleToString(game.Role), result, game.Survived)
+}
+
+func GameInfoToString(game *pb.GameInfo) string {
+	str := fmt.Sprintf("game %s, started at %s, finished at %s, winners: %s",
+		game.GameId, time.Unix(game.StartedAt, 0).Format(time.DateTime),
+		time.Unix(game.FinishedAt, 0).Format(time.DateTime), TeamToString(game.Winner))
+	for _, player := range game.Players {
+		str += "\n" + fmt.Sprintf("player %s, role: %s, survived: %t", player.Username, RoleToString(player.Role), player.Liveness)
+	}
+
+	for _, phase := range game.Phases {
+		str += "\n\n" + PhaseToString(phase.Phase, phase.Number) + ":"
+		for _, vote := range phase.Votes {
+			str += fmt.Sprintf("\n  %s voted for %s", vote.Username, vote.Voted)
+		}
+		for _, check := range phase.Checks {
+			str += fmt.Sprintf("\n  %s checked %s: %s", check.Username, check.Target, RoleToString(check.TargetRole))
+		}
+		for _, username := range phase.Removed {
+			str += fmt.Sprintf("\n  %s was removed from the game", username)
+		}
+		if phase.Eliminated != "" {
+			str += fmt.Sprintf("\n  %s was eliminated", phase.Eliminated)
+		}
+	}
+	return str
+}
+
+func StatsToString(stats *pb.PlayerStats) string {
+	str := fmt.Sprintf("player %s: rating %.0f, %d games, %d wins, %d losses, survived %.0f%%",
+		stats.Username, stats.Rating, stats.Games, stats.Wins, stats.Losses, stats.SurvivalRate*100)
+	for _, team := range stats.Teams {
+		str += fmt.Sprintf("\nfor %s: %d wins of %d games", TeamToString(team.Team), team.Wins, team.Games)
+	}
+	for _, role := range stats.Roles {
+		str += fmt.Sprintf("\nas %s: %d wins of %d games", RoleToString(role.Role), role.Wins, role.Games)
+	}
+	if stats.Checks > 0 {
+		str += fmt.Sprintf("\nsheriff checks: %d, found mafia in %.0f%%", stats.Checks, stats.CheckAccuracy*100)
+	}
+	if stats.MafiaVotes > 0 {
+		str += fmt.Sprintf("\nmafia votes: %d, successful kills %.0f%%", stats.MafiaVotes, stats.KillSuccess*100)
+	}
+	return str
+}
@@ -15,6 +15,7 @@ type Messenger struct {
 
 	input  chan string
 	output chan string
+	done   chan struct{}
 }
 
 func NewMessenger(ctx context.Context) *Messenger {
@@ -26,6 +27,7 @@ func NewMessenger(ctx context.Context) *Messenger {
 		scanner: bufio.NewScanner(os.Stdin),
 		input:   make(chan string, 10),
 		output:  make(chan string, 10),
+		done:    make(chan struct{}),
 	}
 }
 
@@ -34,6 +36,10 @@ func (m *Messenger) Start() {
 	go m.write()
 }
 
+func (m *Messenger) StartOutput() {
+	go m.write()
+}
+
 func (m *Messenger) read() {
 	for m.scanner.Scan() {
 		m.input <- m.scanner.Text()
@@ -42,6 +48,12 @@ func (m *Messenger) read() {
 
 func (m *Messenger) write() {
 	for s := range m.output {
-		_, _ = fmt.Fprintf(os.Stdout, s)
+		_, _ = fmt.Fprint(os.Stdout, s)
 	}
+	close(m.done)
+}
+
+func (m *Messenger) Stop() {
+	close(m.output)
+	<-m.done
 }
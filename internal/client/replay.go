@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"soa_hw_2/internal/pb"
+	"strconv"
+	"time"
+)
+
+const MaxReplayPause = 5 * time.Second
+
+func ParseReplayArgs(fields []string) (string, float64, error) {
+	if len(fields) == 0 || len(fields) > 2 {
+		return "", 0, fmt.Errorf("usage: replay {id} [speed]")
+	}
+
+	speed := 1.0
+	if len(fields) == 2 {
+		var err error
+		speed, err = strconv.ParseFloat(fields[1], 64)
+		if err != nil || speed <= 0 {
+			return "", 0, fmt.Errorf("speed should be a positive number")
+		}
+	}
+	return fields[0], speed, nil
+}
+
+func Replay(ctx context.Context, timeline *pb.GameTimeline, speed float64, handle func(*pb.SessionEvent)) {
+	var last int64
+	for _, event := range timeline.Events {
+		pause := time.Duration(float64(event.OffsetMs-last)/speed) * time.Millisecond
+		if pause > MaxReplayPause {
+			pause = MaxReplayPause
+		}
+		last = event.OffsetMs
+
+		select {
+		case <-time.After(pause):
+		case <-ctx.Done():
+			return
+		}
+		handle(event.Event)
+	}
+}
@@ -0,0 +1,213 @@
+package client
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"soa_hw_2/internal/pb"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var scriptMarshaler = protojson.MarshalOptions{EmitUnpopulated: true}
+
+// scriptLine is one JSON line of script output: either a session event or result of an input command.
+type scriptLine struct {
+	Event   json.RawMessage `json:"event,omitempty"`
+	Input   string          `json:"input,omitempty"`
+	Command string          `json:"command,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Code    string          `json:"code,omitempty"`
+	Error   string          `json:"error,omitempty"`
+}
+
+// Script runs commands read line by line without terminal and prints session events and command
+// results as JSON lines. Besides client commands it understands `wait {event} [timeout]`, which blocks
+// until the next not yet awaited event of that kind (e.g. phaseInfo), and `sleep {duration}`.
+type Script struct {
+	client     *Client
+	commands   *Commands
+	directives *Commands
+
+	out      io.Writer
+	outMutex sync.Mutex
+
+	seen     map[string]int
+	consumed map[string]int
+	changed  chan struct{}
+	closed   bool
+	mutex    sync.Mutex
+}
+
+func NewScript(client *Client, commands *Commands, out io.Writer) *Script {
+	return &Script{
+		client:   client,
+		commands: commands,
+		directives: NewCommands(
+			&Command{Name: "wait", Args: "{event} [timeout]", Help: "wait for the next session event of the kind"},
+			&Command{Name: "sleep", Args: "{duration}", Help: "pause the script"},
+		),
+		out:      out,
+		seen:     make(map[string]int),
+		consumed: make(map[string]int),
+		changed:  make(chan struct{}),
+	}
+}
+
+// Run executes commands until input is over, empty lines and lines starting with # are skipped.
+func (s *Script) Run(input io.Reader) error {
+	go s.forwardEvents()
+
+	scanner := bufio.NewScanner(input)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		s.execute(line)
+	}
+	return scanner.Err()
+}
+
+func (s *Script) execute(line string) {
+	result := scriptLine{Input: line}
+
+	commands := s.commands
+	name, _, _ := strings.Cut(line, " ")
+	_, isDirective := s.directives.Find(name)
+	if isDirective {
+		commands = s.directives
+	}
+
+	var resp proto.Message
+	command, args, err := commands.Parse(line)
+	switch {
+	case err != nil:
+	case isDirective:
+		err = s.runDirective(command.Name, args)
+	case command.Call == nil:
+		err = fmt.Errorf("%s is not supported in script", command.Name)
+	default:
+		resp, err = command.Call(s.client, args)
+	}
+
+	if command != nil {
+		result.Command = command.Name
+	}
+	if err != nil {
+		st, ok := status.FromError(err)
+		if ok {
+			result.Code = st.Code().String()
+		}
+		result.Error = st.Message()
+	} else if resp != nil {
+		result.Result = s.marshal(resp)
+	}
+	s.write(result)
+}
+
+func (s *Script) runDirective(name string, args []string) error {
+	var timeout time.Duration
+	var err error
+	switch name {
+	case "sleep":
+		timeout, err = time.ParseDuration(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", args[0])
+		}
+		time.Sleep(timeout)
+		return nil
+	case "wait":
+		if len(args) == 2 {
+			timeout, err = time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid timeout %q", args[1])
+			}
+		}
+		return s.wait(args[0], timeout)
+	}
+	return nil
+}
+
+func (s *Script) wait(name string, timeout time.Duration) error {
+	if (&pb.SessionEvent{}).ProtoReflect().Descriptor().Fields().ByJSONName(name) == nil {
+		return fmt.Errorf("unknown event %q, expected field of SessionEvent like phaseInfo", name)
+	}
+
+	var deadline <-chan time.Time
+	if timeout > 0 {
+		deadline = time.After(timeout)
+	}
+	for {
+		s.mutex.Lock()
+		if s.seen[name] > s.consumed[name] {
+			s.consumed[name]++
+			s.mutex.Unlock()
+			return nil
+		}
+		if s.closed {
+			s.mutex.Unlock()
+			return fmt.Errorf("session is closed")
+		}
+		changed := s.changed
+		s.mutex.Unlock()
+
+		select {
+		case <-changed:
+		case <-deadline:
+			return fmt.Errorf("timeout waiting for %s", name)
+		}
+	}
+}
+
+func (s *Script) forwardEvents() {
+	for event := range s.client.Events() {
+		s.write(scriptLine{Event: s.marshal(event)})
+
+		s.mutex.Lock()
+		s.seen[EventName(event)]++
+		close(s.changed)
+		s.changed = make(chan struct{})
+		s.mutex.Unlock()
+	}
+
+	s.mutex.Lock()
+	s.closed = true
+	close(s.changed)
+	s.mutex.Unlock()
+}
+
+// marshal returns protojson of the message, json.Marshal compacts it into a single line.
+func (s *Script) marshal(message proto.Message) json.RawMessage {
+	data, err := scriptMarshaler.Marshal(message)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+func (s *Script) write(line scriptLine) {
+	data, err := json.Marshal(line)
+	if err != nil {
+		return
+	}
+	s.outMutex.Lock()
+	defer s.outMutex.Unlock()
+	_, _ = s.out.Write(append(data, '\n'))
+}
+
+// EventName returns JSON name of the event kind, e.g. phaseInfo.
+func EventName(event *pb.SessionEvent) string {
+	m := event.ProtoReflect()
+	field := m.WhichOneof(m.Descriptor().Oneofs().ByName("eventInfo"))
+	if field == nil {
+		return ""
+	}
+	return field.JSONName()
+}
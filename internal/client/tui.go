@@ -0,0 +1,438 @@
+package client
+
+import (
+	"fmt"
+	"soa_hw_2/internal/pb"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"google.golang.org/protobuf/proto"
+)
+
+const playersWidth = 30
+
+var (
+	paneStyle   = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(lipgloss.Color("240"))
+	headerStyle = lipgloss.NewStyle().Bold(true)
+	titleStyle  = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("245"))
+	deadStyle   = lipgloss.NewStyle().Faint(true).Strikethrough(true)
+	deadChat    = lipgloss.NewStyle().Faint(true)
+)
+
+type eventMsg struct {
+	event *pb.SessionEvent
+}
+
+type outputMsg string
+
+type stateMsg struct {
+	state *pb.SessionState
+}
+
+type closedMsg struct{}
+
+type tickMsg struct{}
+
+// TUI is a full-screen alternative to Messenger: handler output goes to the event log pane,
+// chat messages go to the chat pane, and players and phase are kept from session events.
+type TUI struct {
+	client    *Client
+	handler   *Handler
+	messenger *Messenger
+	program   *tea.Program
+}
+
+func NewTUI(client *Client, handler *Handler, messenger *Messenger) *TUI {
+	return &TUI{
+		client:    client,
+		handler:   handler,
+		messenger: messenger,
+	}
+}
+
+func (t *TUI) Run() error {
+	t.program = tea.NewProgram(newTUIModel(t.client, t.handler.Commands(), t.messenger.input), tea.WithAltScreen())
+
+	go t.handler.handleInput()
+	go t.forwardEvents()
+	go t.forwardOutput()
+
+	_, err := t.program.Run()
+	return err
+}
+
+func (t *TUI) forwardEvents() {
+	t.handler.handleHelp()
+	for event := range t.client.Events() {
+		t.program.Send(eventMsg{event})
+		if event.GetChatInfo() == nil {
+			t.handler.HandleEvent(event)
+		}
+	}
+	t.program.Send(closedMsg{})
+}
+
+func (t *TUI) forwardOutput() {
+	for s := range t.messenger.output {
+		t.program.Send(outputMsg(strings.Trim(s, "\n")))
+	}
+}
+
+type tuiModel struct {
+	client   *Client
+	commands *Commands
+	input    chan<- string
+
+	width  int
+	height int
+
+	host    string
+	lobby   []*pb.SessionEvent_LobbyPlayer
+	players []*pb.Player
+	self    *pb.Player
+	known   map[string]pb.Role
+
+	started     bool
+	finished    bool
+	phase       pb.Phase
+	number      int32
+	secondsLeft int32
+	paused      bool
+
+	log      []string
+	chat     []string
+	logView  viewport.Model
+	chatView viewport.Model
+	prompt   textinput.Model
+
+	history    []string
+	historyPos int
+}
+
+func newTUIModel(client *Client, commands *Commands, input chan<- string) *tuiModel {
+	prompt := textinput.New()
+	prompt.Prompt = "> "
+	prompt.Placeholder = "type a command, e.g. ready, vote {username}, say {message}"
+	prompt.Focus()
+
+	return &tuiModel{
+		client:   client,
+		commands: commands,
+		input:    input,
+		known:    make(map[string]pb.Role),
+		logView:  viewport.New(0, 0),
+		chatView: viewport.New(0, 0),
+		prompt:   prompt,
+	}
+}
+
+func (m *tuiModel) Init() tea.Cmd {
+	return tea.Batch(textinput.Blink, tick())
+}
+
+func tick() tea.Cmd {
+	return tea.Tick(time.Second, func(time.Time) tea.Msg {
+		return tickMsg{}
+	})
+}
+
+func (m *tuiModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.resize()
+	case tea.KeyMsg:
+		return m, m.handleKey(msg)
+	case eventMsg:
+		return m, m.handleEvent(msg.event)
+	case outputMsg:
+		m.log = append(m.log, string(msg))
+		m.refresh(&m.logView, m.log)
+	case stateMsg:
+		m.players = clonePlayers(msg.state.Players)
+		m.self = msg.state.Player
+	case closedMsg:
+		return m, tea.Quit
+	case tickMsg:
+		if m.started && !m.finished && !m.paused && m.secondsLeft > 0 {
+			m.secondsLeft--
+		}
+		return m, tick()
+	}
+
+	var cmd tea.Cmd
+	m.prompt, cmd = m.prompt.Update(msg)
+	return m, cmd
+}
+
+func (m *tuiModel) handleKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.Type {
+	case tea.KeyCtrlC, tea.KeyEsc:
+		return tea.Quit
+	case tea.KeyEnter:
+		line := strings.TrimSpace(m.prompt.Value())
+		m.prompt.Reset()
+		if line == "" {
+			return nil
+		}
+		if len(m.history) == 0 || m.history[len(m.history)-1] != line {
+			m.history = append(m.history, line)
+		}
+		m.historyPos = len(m.history)
+		m.log = append(m.log, "> "+line)
+		m.refresh(&m.logView, m.log)
+		return func() tea.Msg {
+			m.input <- line
+			return nil
+		}
+	case tea.KeyUp:
+		if m.historyPos > 0 {
+			m.historyPos--
+			m.prompt.SetValue(m.history[m.historyPos])
+			m.prompt.CursorEnd()
+		}
+		return nil
+	case tea.KeyDown:
+		if m.historyPos < len(m.history)-1 {
+			m.historyPos++
+			m.prompt.SetValue(m.history[m.historyPos])
+			m.prompt.CursorEnd()
+		} else {
+			m.historyPos = len(m.history)
+			m.prompt.Reset()
+		}
+		return nil
+	case tea.KeyTab:
+		m.complete()
+		return nil
+	case tea.KeyPgUp:
+		m.logView.ViewUp()
+		return nil
+	case tea.KeyPgDown:
+		m.logView.ViewDown()
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.prompt, cmd = m.prompt.Update(msg)
+	return cmd
+}
+
+func (m *tuiModel) complete() {
+	candidates := m.commands.Complete(m.prompt.Value(), m.usernames())
+	if len(candidates) == 0 {
+		return
+	}
+	if len(candidates) > 1 {
+		m.log = append(m.log, strings.Join(candidates, "  "))
+		m.refresh(&m.logView, m.log)
+	}
+	if prefix := CommonPrefix(candidates); len(prefix) > len(m.prompt.Value()) {
+		m.prompt.SetValue(prefix)
+		m.prompt.CursorEnd()
+	}
+}
+
+// usernames completes players of the latest state, or lobby players before the game is started.
+func (m *tuiModel) usernames() []string {
+	usernames := []string{}
+	if !m.started {
+		for _, player := range m.lobby {
+			usernames = append(usernames, player.Username)
+		}
+		return usernames
+	}
+	for _, player := range m.players {
+		usernames = append(usernames, player.Username)
+	}
+	return usernames
+}
+
+func (m *tuiModel) handleEvent(event *pb.SessionEvent) tea.Cmd {
+	switch info := event.EventInfo.(type) {
+	case *pb.SessionEvent_LobbyUpdate:
+		m.host = info.LobbyUpdate.Host
+		m.lobby = info.LobbyUpdate.Players
+	case *pb.SessionEvent_StartInfo:
+		m.started = true
+		m.players = clonePlayers(info.StartInfo.Players)
+		return m.fetchState
+	case *pb.SessionEvent_PhaseInfo_:
+		m.started = true
+		m.phase = info.PhaseInfo.Phase
+		m.number = info.PhaseInfo.Number
+		m.secondsLeft = info.PhaseInfo.SecondsLeft
+		m.paused = info.PhaseInfo.Paused
+		return m.fetchState
+	case *pb.SessionEvent_VoteInfo_:
+		m.setAlive(info.VoteInfo.Username, false)
+		return m.fetchState
+	case *pb.SessionEvent_NightActionInfo_:
+		if info.NightActionInfo.TargetRole != pb.Role_UNKNOWN_ROLE {
+			m.known[info.NightActionInfo.Target] = info.NightActionInfo.TargetRole
+		}
+	case *pb.SessionEvent_ModeratorActionInfo_:
+		switch info.ModeratorActionInfo.Action {
+		case pb.ModeratorAction_PAUSE_TIMER:
+			m.paused = true
+		case pb.ModeratorAction_RESUME_TIMER:
+			m.paused = false
+		case pb.ModeratorAction_KILL_PLAYER, pb.ModeratorAction_KICK_PLAYER:
+			m.setAlive(info.ModeratorActionInfo.Username, false)
+		case pb.ModeratorAction_REVIVE_PLAYER:
+			m.setAlive(info.ModeratorActionInfo.Username, true)
+		}
+		return m.fetchState
+	case *pb.SessionEvent_FinishInfo:
+		m.finished = true
+		m.players = clonePlayers(info.FinishInfo.Players)
+	case *pb.SessionEvent_ChatInfo_:
+		chat := info.ChatInfo
+		line := fmt.Sprintf("[%s]: %s", chat.Username, chat.Text)
+		if chat.Channel == pb.ChatChannel_DEAD_CHANNEL {
+			line = deadChat.Render(fmt.Sprintf("[dead %s]: %s", chat.Username, chat.Text))
+		}
+		m.chat = append(m.chat, line)
+		m.refresh(&m.chatView, m.chat)
+	}
+	return nil
+}
+
+// fetchState refreshes players from server, it fails for spectators and moderators
+// who keep players from events only.
+func (m *tuiModel) fetchState() tea.Msg {
+	state, err := m.client.GetState()
+	if err != nil {
+		return nil
+	}
+	return stateMsg{state}
+}
+
+// clonePlayers copies players into the model, since events are shared with handler goroutine
+// and setAlive changes them.
+func clonePlayers(players []*pb.Player) []*pb.Player {
+	cloned := make([]*pb.Player, 0, len(players))
+	for _, player := range players {
+		cloned = append(cloned, proto.Clone(player).(*pb.Player))
+	}
+	return cloned
+}
+
+func (m *tuiModel) setAlive(username string, alive bool) {
+	for _, player := range m.players {
+		if player.Username == username {
+			player.Liveness = alive
+		}
+	}
+}
+
+func (m *tuiModel) role(player *pb.Player) pb.Role {
+	if player.Role != pb.Role_UNKNOWN_ROLE {
+		return player.Role
+	}
+	if role, ok := m.known[player.Username]; ok {
+		return role
+	}
+	return m.client.CheckedRole(player.Username)
+}
+
+func (m *tuiModel) resize() {
+	bodyHeight := m.height - 2
+	rightWidth := m.width - playersWidth - 2
+	logHeight := bodyHeight * 2 / 3
+
+	m.logView.Width = rightWidth
+	m.logView.Height = max(logHeight-2, 1)
+	m.chatView.Width = rightWidth
+	m.chatView.Height = max(bodyHeight-logHeight-2, 1)
+	m.prompt.Width = m.width - len(m.prompt.Prompt) - 1
+
+	m.refresh(&m.logView, m.log)
+	m.refresh(&m.chatView, m.chat)
+}
+
+func (m *tuiModel) refresh(view *viewport.Model, lines []string) {
+	atBottom := view.AtBottom()
+	view.SetContent(lipgloss.NewStyle().Width(view.Width).Render(strings.Join(lines, "\n")))
+	if atBottom {
+		view.GotoBottom()
+	}
+}
+
+func (m *tuiModel) View() string {
+	if m.width == 0 {
+		return ""
+	}
+
+	bodyHeight := m.height - 2
+	players := paneStyle.Copy().Width(playersWidth - 2).Height(bodyHeight - 2).Render(m.playersView())
+	logPane := paneStyle.Render(m.logView.View())
+	chatPane := paneStyle.Render(m.chatView.View())
+	body := lipgloss.JoinHorizontal(lipgloss.Top, players, lipgloss.JoinVertical(lipgloss.Left, logPane, chatPane))
+
+	return lipgloss.JoinVertical(lipgloss.Left, m.headerView(), body, m.prompt.View())
+}
+
+func (m *tuiModel) headerView() string {
+	str := "Lobby"
+	switch {
+	case m.finished:
+		str = "Game is over"
+	case m.started && m.phase != pb.Phase_UNKNOWN_PHASE:
+		str = PhaseToString(m.phase, m.number)
+		if m.paused {
+			str += ", timer is paused"
+		} else if m.secondsLeft > 0 {
+			str += fmt.Sprintf(", %d:%02d left", m.secondsLeft/60, m.secondsLeft%60)
+		}
+	}
+	if m.self != nil {
+		str += fmt.Sprintf(" | %s, %s", m.self.Username, RoleToString(m.self.Role))
+		if !m.self.Liveness {
+			str += ", dead"
+		}
+	}
+	return headerStyle.Render(str)
+}
+
+func (m *tuiModel) playersView() string {
+	lines := []string{}
+	if !m.started {
+		lines = append(lines, titleStyle.Render(fmt.Sprintf("Lobby (%d)", len(m.lobby))))
+		for _, player := range m.lobby {
+			line := player.Username
+			if player.Username == m.host {
+				line += " (host)"
+			}
+			if player.Ready {
+				line += " ✓"
+			}
+			lines = append(lines, line)
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	alive := 0
+	for _, player := range m.players {
+		if player.Liveness {
+			alive++
+		}
+	}
+	lines = append(lines, titleStyle.Render(fmt.Sprintf("Players (%d alive)", alive)))
+	for _, player := range m.players {
+		line := player.Username
+		if role := m.role(player); role != pb.Role_UNKNOWN_ROLE {
+			line += ", " + RoleToString(role)
+		}
+		if player.Liveness {
+			lines = append(lines, "● "+line)
+		} else {
+			lines = append(lines, "✗ "+deadStyle.Render(line))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,88 @@
+package config
+
+import (
+	"errors"
+	"flag"
+)
+
+type ClientConfig struct {
+	Server   string          `yaml:"server"`
+	LogLevel string          `yaml:"log_level"`
+	TLS      ClientTLSConfig `yaml:"tls"`
+	Tracing  TracingConfig   `yaml:"tracing"`
+	TUI      bool            `yaml:"tui"`
+
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	Register bool   `yaml:"-"`
+	Script   string `yaml:"-"`
+
+	Spectate string `yaml:"-"`
+	Moderate bool   `yaml:"-"`
+	Resume   bool   `yaml:"-"`
+	Session  string `yaml:"-"`
+}
+
+type ClientTLSConfig struct {
+	Enabled    bool   `yaml:"enabled"`
+	CA         string `yaml:"ca"`
+	Cert       string `yaml:"cert"`
+	Key        string `yaml:"key"`
+	ServerName string `yaml:"server_name"`
+}
+
+func DefaultClientConfig() *ClientConfig {
+	return &ClientConfig{
+		Server:   "dns:///mafiaserver:9000",
+		LogLevel: "info",
+		Tracing:  DefaultTracingConfig(),
+	}
+}
+
+func LoadClientConfig(args []string) (*ClientConfig, error) {
+	cfg := DefaultClientConfig()
+	err := load("client", args, cfg, func(fs *flag.FlagSet) {
+		fs.StringVar(&cfg.Server, "server", cfg.Server, "address of mafia server")
+		fs.StringVar(&cfg.LogLevel, "log-level", cfg.LogLevel, "log level: debug, info, warn or error")
+		fs.BoolVar(&cfg.TLS.Enabled, "tls", cfg.TLS.Enabled, "connect to server over TLS")
+		fs.StringVar(&cfg.TLS.CA, "tls-ca", cfg.TLS.CA, "CA certificate file to verify server certificate (implies -tls)")
+		fs.StringVar(&cfg.TLS.Cert, "tls-cert", cfg.TLS.Cert, "client TLS certificate file for mutual TLS, requires -tls-key (implies -tls)")
+		fs.StringVar(&cfg.TLS.Key, "tls-key", cfg.TLS.Key, "client TLS private key file for mutual TLS, requires -tls-cert (implies -tls)")
+		fs.StringVar(&cfg.TLS.ServerName, "tls-server-name", cfg.TLS.ServerName, "server name to verify in server certificate (implies -tls)")
+		registerTracing(fs, &cfg.Tracing)
+		fs.BoolVar(&cfg.TUI, "tui", cfg.TUI, "run full-screen terminal UI instead of line output")
+		fs.StringVar(&cfg.Username, "username", cfg.Username, "account username, asked on start if empty")
+		fs.StringVar(&cfg.Password, "password", cfg.Password, "account password, asked on start if empty (prefer MAFIA_PASSWORD)")
+		fs.BoolVar(&cfg.Register, "register", cfg.Register, "register a new account with -username and -password instead of logging in")
+		fs.StringVar(&cfg.Script, "script", cfg.Script, "file with commands to run non-interactively (- for stdin), prints events and results as JSON lines")
+		fs.StringVar(&cfg.Spectate, "spectate", cfg.Spectate, "id of session to watch instead of playing")
+		fs.BoolVar(&cfg.Moderate, "moderate", cfg.Moderate, "moderate session (-session or the one you are invited to) instead of playing")
+		fs.BoolVar(&cfg.Resume, "resume", cfg.Resume, "rejoin a game restored after server restart")
+		fs.StringVar(&cfg.Session, "session", cfg.Session, "id of session to moderate or resume")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = ParseLogLevel(cfg.LogLevel)
+	if err != nil {
+		return nil, err
+	}
+	return cfg, cfg.Validate()
+}
+
+func (c *ClientConfig) Validate() error {
+	if c.Script != "" {
+		if c.TUI {
+			return errors.New("-script can't be used with -tui")
+		}
+		// prompts would be mixed with commands read from stdin
+		if c.Spectate == "" && (c.Username == "" || c.Password == "") {
+			return errors.New("-script requires -username and -password")
+		}
+	}
+	if c.Register && c.Spectate != "" {
+		return errors.New("-register can't be used with -spectate")
+	}
+	return c.Tracing.Validate()
+}
@@ -0,0 +1,111 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const EnvPrefix = "MAFIA_"
+
+func load(name string, args []string, cfg interface{}, register func(fs *flag.FlagSet)) error {
+	configFile := os.Getenv(EnvName("config"))
+	probe := flag.NewFlagSet(name, flag.ExitOnError)
+	probe.StringVar(&configFile, "config", configFile, "path to YAML config file")
+	register(probe)
+	_ = probe.Parse(args)
+
+	if configFile != "" {
+		data, err := os.ReadFile(configFile)
+		if err != nil {
+			return fmt.Errorf("failed to read config: %s", err)
+		}
+		err = yaml.Unmarshal(data, cfg)
+		if err != nil {
+			return fmt.Errorf("failed to parse config %s: %s", configFile, err)
+		}
+	}
+
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	fs.StringVar(&configFile, "config", configFile, "path to YAML config file")
+	register(fs)
+
+	var envErr error
+	fs.VisitAll(func(f *flag.Flag) {
+		value, ok := os.LookupEnv(EnvName(f.Name))
+		if !ok || envErr != nil {
+			return
+		}
+		err := fs.Set(f.Name, value)
+		if err != nil {
+			envErr = fmt.Errorf("invalid value of %s: %s", EnvName(f.Name), err)
+		}
+	})
+	if envErr != nil {
+		return envErr
+	}
+
+	return fs.Parse(args)
+}
+
+type TracingConfig struct {
+	Exporter string `yaml:"exporter"`
+	Endpoint string `yaml:"endpoint"`
+}
+
+func DefaultTracingConfig() TracingConfig {
+	return TracingConfig{
+		Exporter: "none",
+		Endpoint: "localhost:4317",
+	}
+}
+
+func registerTracing(fs *flag.FlagSet, cfg *TracingConfig) {
+	fs.StringVar(&cfg.Exporter, "trace-exporter", cfg.Exporter, "trace exporter: none, stdout or otlp")
+	fs.StringVar(&cfg.Endpoint, "trace-endpoint", cfg.Endpoint, "OTLP gRPC collector address for otlp trace exporter")
+}
+
+func (c TracingConfig) Validate() error {
+	switch c.Exporter {
+	case "none", "stdout", "otlp":
+		return nil
+	default:
+		return errors.New("trace exporter should be one of none, stdout, otlp")
+	}
+}
+
+func EnvName(flagName string) string {
+	return EnvPrefix + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+func ParseLogLevel(level string) (slog.Level, error) {
+	var parsed slog.Level
+	err := parsed.UnmarshalText([]byte(level))
+	if err != nil {
+		return 0, errors.New("log level should be one of debug, info, warn, error")
+	}
+	return parsed, nil
+}
+
+func NewLogger(w io.Writer, level string, format string) (*slog.Logger, error) {
+	parsed, err := ParseLogLevel(level)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &slog.HandlerOptions{Level: parsed}
+	switch format {
+	case "text":
+		return slog.New(slog.NewTextHandler(w, opts)), nil
+	case "json":
+		return slog.New(slog.NewJSONHandler(w, opts)), nil
+	default:
+		return nil, errors.New("log format should be one of text, json")
+	}
+}
@@ -0,0 +1,116 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestServerConfigPrecedence(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "server.yaml")
+	err := os.WriteFile(file, []byte("address: 0.0.0.0:7000\nlog_level: warn\ngame:\n  phase_duration: 1m\n"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		env      map[string]string
+		args     []string
+		address  string
+		logLevel string
+		phase    time.Duration
+	}{
+		{"defaults", nil, nil, "0.0.0.0:9000", "info", 3 * time.Minute},
+		{"config file", nil, []string{"-config", file}, "0.0.0.0:7000", "warn", time.Minute},
+		{"config file from env", map[string]string{"MAFIA_CONFIG": file}, nil, "0.0.0.0:7000", "warn", time.Minute},
+		{"env overrides config file", map[string]string{"MAFIA_ADDRESS": "0.0.0.0:8000"}, []string{"-config", file}, "0.0.0.0:8000", "warn", time.Minute},
+		{"flag overrides env", map[string]string{"MAFIA_ADDRESS": "0.0.0.0:8000"}, []string{"-address", "0.0.0.0:6000"}, "0.0.0.0:6000", "info", 3 * time.Minute},
+		{"flag overrides config file", nil, []string{"-config", file, "-log-level", "debug", "-phase-duration", "0s"}, "0.0.0.0:7000", "debug", 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for key, value := range test.env {
+				t.Setenv(key, value)
+			}
+
+			cfg, err := LoadServerConfig(test.args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.Address != test.address || cfg.LogLevel != test.logLevel || cfg.Game.PhaseDuration != test.phase {
+				t.Fatalf("expected %s, %s, %s, got %s, %s, %s", test.address, test.logLevel, test.phase,
+					cfg.Address, cfg.LogLevel, cfg.Game.PhaseDuration)
+			}
+		})
+	}
+}
+
+func TestServerConfigValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		env   map[string]string
+		args  []string
+		valid bool
+	}{
+		{"defaults", nil, nil, true},
+		{"invalid env value", map[string]string{"MAFIA_MAFIA_COUNT": "many"}, nil, false},
+		{"unknown log level", nil, []string{"-log-level", "verbose"}, false},
+		{"unknown trace exporter", nil, []string{"-trace-exporter", "zipkin"}, false},
+		{"too many mafia", nil, []string{"-mafia-count", "2"}, false},
+		{"min players above max", nil, []string{"-min-players", "5"}, false},
+		{"negative match window", nil, []string{"-match-window", "-1"}, false},
+		{"negative resume timeout", nil, []string{"-resume-timeout", "-1s"}, false},
+		{"gateway without TLS", nil, []string{"-gateway-address", "0.0.0.0:8080"}, false},
+		{"gateway with TLS", nil, []string{"-gateway-address", "0.0.0.0:8080", "-tls-cert", "cert.pem", "-tls-key", "key.pem"}, true},
+		{"gateway with mutual TLS", nil, []string{"-gateway-address", "0.0.0.0:8080", "-tls-cert", "cert.pem", "-tls-key", "key.pem", "-tls-client-ca", "ca.pem"}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for key, value := range test.env {
+				t.Setenv(key, value)
+			}
+
+			_, err := LoadServerConfig(test.args)
+			if test.valid && err != nil {
+				t.Fatal(err)
+			}
+			if !test.valid && err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestClientConfigValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		valid bool
+	}{
+		{"interactive", nil, true},
+		{"script with credentials", []string{"-script", "-", "-username", "alice", "-password", "secret"}, true},
+		{"script without credentials", []string{"-script", "-"}, false},
+		{"script of spectator", []string{"-script", "-", "-spectate", "id"}, true},
+		{"script of moderator without credentials", []string{"-script", "-", "-moderate"}, false},
+		{"register", []string{"-register", "-username", "alice", "-password", "secret"}, true},
+		{"register spectator", []string{"-register", "-spectate", "id"}, false},
+		{"script with terminal UI", []string{"-script", "-", "-tui", "-username", "alice", "-password", "secret"}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := LoadClientConfig(test.args)
+			if test.valid && err != nil {
+				t.Fatal(err)
+			}
+			if !test.valid && err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+)
+
+type ServerConfig struct {
+	Address        string `yaml:"address"`
+	AdminAddress   string `yaml:"admin_address"`
+	MetricsAddress string `yaml:"metrics_address"`
+	GatewayAddress string `yaml:"gateway_address"`
+	DataDir        string `yaml:"data_dir"`
+	LogLevel       string `yaml:"log_level"`
+	LogFormat      string `yaml:"log_format"`
+
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	PersistSessions bool          `yaml:"persist_sessions"`
+	ResumeTimeout   time.Duration `yaml:"resume_timeout"`
+	Health          bool          `yaml:"health"`
+	Reflection      bool          `yaml:"reflection"`
+
+	KeepAlive   KeepAliveConfig `yaml:"keepalive"`
+	Game        GameConfig      `yaml:"game"`
+	Matchmaking MatchConfig     `yaml:"matchmaking"`
+	Auth        AuthConfig      `yaml:"auth"`
+	TLS         ServerTLSConfig `yaml:"tls"`
+	Tracing     TracingConfig   `yaml:"tracing"`
+}
+
+type KeepAliveConfig struct {
+	ConnectionTimeout time.Duration `yaml:"connection_timeout"`
+	Interval          time.Duration `yaml:"interval"`
+}
+
+type GameConfig struct {
+	MafiaCount    int           `yaml:"mafia_count"`
+	SheriffCount  int           `yaml:"sheriff_count"`
+	CivilianCount int           `yaml:"civilian_count"`
+	MinPlayers    int           `yaml:"min_players"`
+	PhaseDuration time.Duration `yaml:"phase_duration"`
+}
+
+type MatchConfig struct {
+	Window       float64 `yaml:"window"`
+	WindowGrowth float64 `yaml:"window_growth"`
+}
+
+type AuthConfig struct {
+	Secret   string        `yaml:"secret"`
+	TokenTTL time.Duration `yaml:"token_ttl"`
+}
+
+type ServerTLSConfig struct {
+	Cert     string `yaml:"cert"`
+	Key      string `yaml:"key"`
+	ClientCA string `yaml:"client_ca"`
+}
+
+func DefaultServerConfig() *ServerConfig {
+	return &ServerConfig{
+		Address:        "0.0.0.0:9000",
+		AdminAddress:   "127.0.0.1:9001",
+		MetricsAddress: "0.0.0.0:9090",
+		GatewayAddress: "",
+		DataDir:        "data",
+		LogLevel:       "info",
+		LogFormat:      "text",
+
+		ShutdownTimeout: 30 * time.Second,
+		PersistSessions: true,
+		ResumeTimeout:   10 * time.Minute,
+		Health:          true,
+		Reflection:      true,
+
+		KeepAlive: KeepAliveConfig{
+			ConnectionTimeout: 10 * time.Second,
+			Interval:          500 * time.Millisecond,
+		},
+		Game: GameConfig{
+			MafiaCount:    1,
+			SheriffCount:  1,
+			CivilianCount: 2,
+			MinPlayers:    3,
+			PhaseDuration: 3 * time.Minute,
+		},
+		Matchmaking: MatchConfig{
+			Window:       100,
+			WindowGrowth: 5,
+		},
+		Auth: AuthConfig{
+			TokenTTL: 24 * time.Hour,
+		},
+		Tracing: DefaultTracingConfig(),
+	}
+}
+
+func LoadServerConfig(args []string) (*ServerConfig, error) {
+	cfg := DefaultServerConfig()
+	err := load("server", args, cfg, func(fs *flag.FlagSet) {
+		fs.StringVar(&cfg.Address, "address", cfg.Address, "address to listen for players")
+		fs.StringVar(&cfg.AdminAddress, "admin-address", cfg.AdminAddress, "address to listen for admin service")
+		fs.StringVar(&cfg.MetricsAddress, "metrics-address", cfg.MetricsAddress, "address to serve Prometheus metrics, empty disables metrics")
+		fs.StringVar(&cfg.GatewayAddress, "gateway-address", cfg.GatewayAddress, "address to serve HTTP/JSON API and web UI over TLS (requires -tls-cert and -tls-key), empty disables gateway")
+		fs.StringVar(&cfg.DataDir, "data-dir", cfg.DataDir, "directory to store server data")
+		fs.StringVar(&cfg.LogLevel, "log-level", cfg.LogLevel, "log level: debug, info, warn or error")
+		fs.StringVar(&cfg.LogFormat, "log-format", cfg.LogFormat, "log format: text or json")
+		fs.DurationVar(&cfg.ShutdownTimeout, "shutdown-timeout", cfg.ShutdownTimeout, "time to wait for active games to end on shutdown")
+		fs.BoolVar(&cfg.PersistSessions, "persist-sessions", cfg.PersistSessions, "save started games to data directory and restore them on start")
+		fs.DurationVar(&cfg.ResumeTimeout, "resume-timeout", cfg.ResumeTimeout, "time to wait for players of restored game to resume before terminating it, 0 waits forever")
+		fs.BoolVar(&cfg.Health, "health", cfg.Health, "register gRPC health checking service")
+		fs.BoolVar(&cfg.Reflection, "reflection", cfg.Reflection, "register gRPC server reflection service")
+		fs.DurationVar(&cfg.KeepAlive.ConnectionTimeout, "connection-timeout", cfg.KeepAlive.ConnectionTimeout, "timeout of connection establishment")
+		fs.DurationVar(&cfg.KeepAlive.Interval, "keepalive", cfg.KeepAlive.Interval, "keepalive ping interval and timeout")
+		fs.IntVar(&cfg.Game.MafiaCount, "mafia-count", cfg.Game.MafiaCount, "number of mafia players in game")
+		fs.IntVar(&cfg.Game.SheriffCount, "sheriff-count", cfg.Game.SheriffCount, "number of sheriffs in game")
+		fs.IntVar(&cfg.Game.CivilianCount, "civilian-count", cfg.Game.CivilianCount, "number of civilians in full game")
+		fs.IntVar(&cfg.Game.MinPlayers, "min-players", cfg.Game.MinPlayers, "minimal number of players to start game")
+		fs.DurationVar(&cfg.Game.PhaseDuration, "phase-duration", cfg.Game.PhaseDuration, "duration of day and night, 0 disables phase timer")
+		fs.Float64Var(&cfg.Matchmaking.Window, "match-window", cfg.Matchmaking.Window, "max rating difference between player and lobby to join it")
+		fs.Float64Var(&cfg.Matchmaking.WindowGrowth, "match-window-growth", cfg.Matchmaking.WindowGrowth, "rating window growth per second the longest waiting player of lobby waits")
+		fs.StringVar(&cfg.Auth.Secret, "auth-secret", cfg.Auth.Secret, "secret to sign tokens, random if empty")
+		fs.DurationVar(&cfg.Auth.TokenTTL, "token-ttl", cfg.Auth.TokenTTL, "lifetime of issued tokens")
+		fs.StringVar(&cfg.TLS.Cert, "tls-cert", cfg.TLS.Cert, "server TLS certificate file")
+		fs.StringVar(&cfg.TLS.Key, "tls-key", cfg.TLS.Key, "server TLS private key file")
+		fs.StringVar(&cfg.TLS.ClientCA, "tls-client-ca", cfg.TLS.ClientCA, "CA certificate file to verify client certificates (enables mutual TLS)")
+		registerTracing(fs, &cfg.Tracing)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, cfg.Validate()
+}
+
+func (c *ServerConfig) Validate() error {
+	_, err := NewLogger(io.Discard, c.LogLevel, c.LogFormat)
+	if err != nil {
+		return err
+	}
+	err = c.Tracing.Validate()
+	if err != nil {
+		return err
+	}
+
+	game := c.Game
+	if game.MafiaCount < 1 || game.SheriffCount < 0 || game.CivilianCount < 0 {
+		return fmt.Errorf("game should have at least 1 mafia and non-negative number of sheriffs and civilians")
+	}
+	maxPlayers := game.MafiaCount + game.SheriffCount + game.CivilianCount
+	if game.MinPlayers <= 2*game.MafiaCount || game.MinPlayers > maxPlayers {
+		return fmt.Errorf("min players should be greater than doubled mafia count and not greater than %d", maxPlayers)
+	}
+	if game.MinPlayers < game.MafiaCount+game.SheriffCount {
+		return fmt.Errorf("min players should be enough to assign all mafia and sheriff roles")
+	}
+	if c.Matchmaking.Window < 0 || c.Matchmaking.WindowGrowth < 0 {
+		return fmt.Errorf("matchmaking window and its growth should be non-negative")
+	}
+	if game.PhaseDuration < 0 || c.ShutdownTimeout < 0 || c.ResumeTimeout < 0 || c.Auth.TokenTTL <= 0 {
+		return fmt.Errorf("durations should be positive")
+	}
+	if c.GatewayAddress != "" {
+		// passwords and tokens go through gateway, so it is served only over TLS
+		if c.TLS.Cert == "" || c.TLS.Key == "" {
+			return fmt.Errorf("gateway requires -tls-cert and -tls-key")
+		}
+		// gateway calls Mafia service in-process and can't check client certificates
+		if c.TLS.ClientCA != "" {
+			return fmt.Errorf("gateway can't be used with -tls-client-ca")
+		}
+	}
+	return nil
+}
+
+func (c *ServerConfig) AccountsPath() string {
+	return filepath.Join(c.DataDir, "accounts.json")
+}
+
+func (c *ServerConfig) SessionsDir() string {
+	return filepath.Join(c.DataDir, "sessions")
+}
+
+func (c *ServerConfig) RatingsPath() string {
+	return filepath.Join(c.DataDir, "ratings.json")
+}
+
+func (c *ServerConfig) GamesDir() string {
+	return filepath.Join(c.DataDir, "games")
+}
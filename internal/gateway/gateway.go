@@ -0,0 +1,274 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"soa_hw_2/internal/pb"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type Gateway struct {
+	client pb.MafiaClient
+	mux    *http.ServeMux
+}
+
+type eventStream interface {
+	Header() (metadata.MD, error)
+	Recv() (*pb.SessionEvent, error)
+}
+
+var marshaler = protojson.MarshalOptions{EmitUnpopulated: true}
+var unmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+func New(conn grpc.ClientConnInterface) *Gateway {
+	g := &Gateway{client: pb.NewMafiaClient(conn), mux: http.NewServeMux()}
+
+	g.handle(http.MethodPost, "/api/register", func(ctx context.Context, body []byte) (proto.Message, error) {
+		req := &pb.AccountRequest{}
+		err := decode(body, req)
+		if err != nil {
+			return nil, err
+		}
+		return g.client.Register(ctx, req)
+	})
+	g.handle(http.MethodPost, "/api/login", func(ctx context.Context, body []byte) (proto.Message, error) {
+		req := &pb.AccountRequest{}
+		err := decode(body, req)
+		if err != nil {
+			return nil, err
+		}
+		return g.client.Login(ctx, req)
+	})
+	g.handle(http.MethodGet, "/api/sessions", func(ctx context.Context, body []byte) (proto.Message, error) {
+		return g.client.ListSessions(ctx, &pb.Empty{})
+	})
+	g.handle(http.MethodGet, "/api/session/state", func(ctx context.Context, body []byte) (proto.Message, error) {
+		return g.client.GetSessionState(ctx, &pb.Empty{})
+	})
+	g.handle(http.MethodPost, "/api/session/ready", func(ctx context.Context, body []byte) (proto.Message, error) {
+		req := &pb.ReadyRequest{}
+		err := decode(body, req)
+		if err != nil {
+			return nil, err
+		}
+		return g.client.SetReady(ctx, req)
+	})
+	g.handle(http.MethodPost, "/api/session/start", func(ctx context.Context, body []byte) (proto.Message, error) {
+		return g.client.StartGame(ctx, &pb.Empty{})
+	})
+	g.handle(http.MethodPost, "/api/session/vote", func(ctx context.Context, body []byte) (proto.Message, error) {
+		req := &pb.VoteRequest{}
+		err := decode(body, req)
+		if err != nil {
+			return nil, err
+		}
+		return g.client.Vote(ctx, req)
+	})
+	g.handle(http.MethodPost, "/api/session/check", func(ctx context.Context, body []byte) (proto.Message, error) {
+		req := &pb.CheckRequest{}
+		err := decode(body, req)
+		if err != nil {
+			return nil, err
+		}
+		return g.client.Check(ctx, req)
+	})
+	g.handle(http.MethodPost, "/api/session/message", func(ctx context.Context, body []byte) (proto.Message, error) {
+		req := &pb.ChatRequest{}
+		err := decode(body, req)
+		if err != nil {
+			return nil, err
+		}
+		return g.client.SendMessage(ctx, req)
+	})
+
+	g.handleStream("/api/session/events", func(ctx context.Context, r *http.Request) (eventStream, error) {
+		return g.client.StartSession(ctx, &pb.StartSessionRequest{})
+	})
+	g.handleStream("/api/session/resume", func(ctx context.Context, r *http.Request) (eventStream, error) {
+		return g.client.Resume(ctx, &pb.ResumeRequest{SessionId: r.URL.Query().Get("session_id")})
+	})
+	g.handleStream("/api/session/spectate", func(ctx context.Context, r *http.Request) (eventStream, error) {
+		return g.client.Spectate(ctx, &pb.SpectateRequest{SessionId: r.URL.Query().Get("session_id")})
+	})
+	g.mux.HandleFunc("/api/ws", g.handleWebSocket)
+	return g
+}
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mux.ServeHTTP(w, r)
+}
+
+func (g *Gateway) handle(method string, path string, call func(ctx context.Context, body []byte) (proto.Message, error)) {
+	g.mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s is not allowed", r.Method))
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		resp, err := call(withToken(r), body)
+		if err != nil {
+			writeStatus(w, err)
+			return
+		}
+
+		data, err := marshaler.Marshal(resp)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(data)
+	})
+}
+
+// handleStream forwards server stream as Server-Sent Events: `token` event with player token
+// is sent first if server issued one, then `session` events until stream is closed.
+func (g *Gateway) handleStream(path string, open func(ctx context.Context, r *http.Request) (eventStream, error)) {
+	g.mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s is not allowed", r.Method))
+			return
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			writeError(w, http.StatusInternalServerError, fmt.Errorf("streaming is not supported"))
+			return
+		}
+
+		stream, md, event, err := openStream(withToken(r), r, open)
+		if err != nil && err != io.EOF {
+			writeStatus(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+
+		token, tokenErr := pb.FetchToken(md)
+		if tokenErr == nil {
+			data, _ := json.Marshal(map[string]string{"token": token})
+			writeEvent(w, "token", data)
+		}
+
+		for err == nil {
+			data, marshalErr := marshaler.Marshal(event)
+			if marshalErr == nil {
+				writeEvent(w, "session", data)
+			}
+			flusher.Flush()
+			event, err = stream.Recv()
+		}
+		if err != io.EOF && r.Context().Err() == nil {
+			writeEvent(w, "error", errorBody(err))
+			flusher.Flush()
+		}
+	})
+}
+
+// openStream waits for the first event that server sends right after join, so rejected
+// stream is reported with HTTP status before switching to SSE or WebSocket.
+func openStream(ctx context.Context, r *http.Request, open func(ctx context.Context, r *http.Request) (eventStream, error)) (eventStream, metadata.MD, *pb.SessionEvent, error) {
+	stream, err := open(ctx, r)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	md, err := stream.Header()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	event, err := stream.Recv()
+	return stream, md, event, err
+}
+
+func withToken(r *http.Request) context.Context {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" {
+		token = r.URL.Query().Get("token")
+	}
+	if token == "" {
+		return r.Context()
+	}
+	return metadata.NewOutgoingContext(r.Context(), pb.WithToken(token))
+}
+
+func decode(body []byte, req proto.Message) error {
+	if len(body) == 0 {
+		return nil
+	}
+	err := unmarshaler.Unmarshal(body, req)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request body: %s", err)
+	}
+	return nil
+}
+
+func writeEvent(w io.Writer, name string, data []byte) {
+	fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, data)
+}
+
+func writeStatus(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(HTTPStatus(status.Code(err)))
+	_, _ = w.Write(errorBody(err))
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	data, _ := json.Marshal(map[string]string{"error": err.Error()})
+	_, _ = w.Write(data)
+}
+
+func errorBody(err error) []byte {
+	st := status.Convert(err)
+	data, _ := json.Marshal(map[string]string{"code": st.Code().String(), "error": st.Message()})
+	return data
+}
+
+func HTTPStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	// game rule violations are returned by server as plain errors
+	case codes.Unknown, codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Canceled:
+		return 499
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,298 @@
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"soa_hw_2/internal/pb"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	accountToken = "account-token"
+	playerToken  = "player-token"
+)
+
+type testServer struct {
+	pb.UnimplementedMafiaServer
+}
+
+func tokenFromContext(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	token, _ := pb.FetchToken(md)
+	return token
+}
+
+func (s *testServer) Login(ctx context.Context, req *pb.AccountRequest) (*pb.AccountResponse, error) {
+	if req.Username != "alice" || req.Password != "secret" {
+		return nil, status.Error(codes.Unauthenticated, "invalid username or password")
+	}
+	return &pb.AccountResponse{AccountId: "alice-id", Token: accountToken}, nil
+}
+
+func (s *testServer) Vote(ctx context.Context, req *pb.VoteRequest) (*pb.Empty, error) {
+	if tokenFromContext(ctx) != playerToken {
+		return nil, status.Error(codes.Unauthenticated, "player token is required")
+	}
+	if req.Username == "" {
+		return nil, status.Error(codes.InvalidArgument, "username is required")
+	}
+	return &pb.Empty{}, nil
+}
+
+func (s *testServer) Check(ctx context.Context, req *pb.CheckRequest) (*pb.CheckResponse, error) {
+	if tokenFromContext(ctx) != playerToken {
+		return nil, status.Error(codes.Unauthenticated, "player token is required")
+	}
+	return &pb.CheckResponse{Username: req.Username, Role: pb.Role_MAFIA_ROLE}, nil
+}
+
+func (s *testServer) GetSessionState(ctx context.Context, req *pb.Empty) (*pb.SessionState, error) {
+	if tokenFromContext(ctx) != playerToken {
+		return nil, status.Error(codes.Unauthenticated, "player token is required")
+	}
+	return &pb.SessionState{SessionId: "session-id", Phase: pb.Phase_DAY}, nil
+}
+
+// StartSession joins a lobby and keeps stream open until client leaves.
+func (s *testServer) StartSession(req *pb.StartSessionRequest, stream pb.Mafia_StartSessionServer) error {
+	if tokenFromContext(stream.Context()) != accountToken {
+		return status.Error(codes.Unauthenticated, "account token is required")
+	}
+	err := stream.SendHeader(pb.WithToken(playerToken))
+	if err != nil {
+		return err
+	}
+	err = stream.Send(joinEvent("alice"))
+	if err != nil {
+		return err
+	}
+	<-stream.Context().Done()
+	return nil
+}
+
+// Spectate streams a finished game of session "finished".
+func (s *testServer) Spectate(req *pb.SpectateRequest, stream pb.Mafia_SpectateServer) error {
+	if req.SessionId != "finished" {
+		return status.Errorf(codes.NotFound, "session %s is not found", req.SessionId)
+	}
+	for _, event := range []*pb.SessionEvent{joinEvent("alice"), finishEvent(pb.Team_CIVILIANS)} {
+		err := stream.Send(event)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func joinEvent(username string) *pb.SessionEvent {
+	return &pb.SessionEvent{EventInfo: &pb.SessionEvent_JoinInfo{JoinInfo: &pb.SessionEvent_PlayerJoinInfo{Username: username}}}
+}
+
+func finishEvent(winners pb.Team) *pb.SessionEvent {
+	return &pb.SessionEvent{EventInfo: &pb.SessionEvent_FinishInfo{FinishInfo: &pb.SessionEvent_SessionFinishInfo{Winners: winners}}}
+}
+
+func serve(t *testing.T) *httptest.Server {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := grpc.NewServer()
+	pb.RegisterMafiaServer(srv, &testServer{})
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	gateway := httptest.NewServer(New(conn))
+	t.Cleanup(gateway.Close)
+	return gateway
+}
+
+func TestHandle(t *testing.T) {
+	gateway := serve(t)
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		token    string
+		body     string
+		expected int
+		response string
+	}{
+		{"login", http.MethodPost, "/api/login", "", `{"username": "alice", "password": "secret"}`, http.StatusOK, `"token":"account-token"`},
+		{"login with wrong password", http.MethodPost, "/api/login", "", `{"username": "alice", "password": "wrong"}`, http.StatusUnauthorized, `"code":"Unauthenticated"`},
+		{"login with invalid body", http.MethodPost, "/api/login", "", `{"username": 1}`, http.StatusBadRequest, `"code":"InvalidArgument"`},
+		{"login with unknown fields", http.MethodPost, "/api/login", "", `{"username": "alice", "password": "secret", "remember": true}`, http.StatusOK, `"accountId":"alice-id"`},
+		{"wrong method", http.MethodGet, "/api/login", "", "", http.StatusMethodNotAllowed, `"error":"method GET is not allowed"`},
+		{"vote without token", http.MethodPost, "/api/session/vote", "", `{"username": "bob"}`, http.StatusUnauthorized, `"error":"player token is required"`},
+		{"vote with bearer token", http.MethodPost, "/api/session/vote", "Bearer " + playerToken, `{"username": "bob"}`, http.StatusOK, `{}`},
+		{"vote with plain token", http.MethodPost, "/api/session/vote", playerToken, `{"username": "bob"}`, http.StatusOK, `{}`},
+		{"vote without body", http.MethodPost, "/api/session/vote", "Bearer " + playerToken, "", http.StatusBadRequest, `"error":"username is required"`},
+		{"check result", http.MethodPost, "/api/session/check", "Bearer " + playerToken, `{"username": "bob"}`, http.StatusOK, `"role":"MAFIA_ROLE"`},
+		{"session state", http.MethodGet, "/api/session/state", "Bearer " + playerToken, "", http.StatusOK, `"phase":"DAY"`},
+		{"unimplemented method", http.MethodGet, "/api/sessions", "", "", http.StatusNotImplemented, `"code":"Unimplemented"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req, err := http.NewRequest(test.method, gateway.URL+test.path, strings.NewReader(test.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.token != "" {
+				req.Header.Set("Authorization", test.token)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != test.expected {
+				t.Fatalf("expected status %d, got %d: %s", test.expected, resp.StatusCode, body)
+			}
+			if !strings.Contains(string(body), test.response) {
+				t.Fatalf("expected %s in response, got %s", test.response, body)
+			}
+		})
+	}
+}
+
+type serverEvent struct {
+	name string
+	data string
+}
+
+func readEvents(t *testing.T, body io.Reader, count int) []serverEvent {
+	events := []serverEvent{}
+	scanner := bufio.NewScanner(body)
+	event := serverEvent{}
+	for len(events) < count && scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			event.name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			event.data = strings.TrimPrefix(line, "data: ")
+		case line == "":
+			events = append(events, event)
+			event = serverEvent{}
+		}
+	}
+	if len(events) < count {
+		t.Fatalf("expected %d events, got %v", count, events)
+	}
+	return events
+}
+
+func TestHandleStream(t *testing.T) {
+	gateway := serve(t)
+
+	tests := []struct {
+		name     string
+		path     string
+		token    string
+		expected int
+		events   []string
+	}{
+		{"join with token in header", "/api/session/events", "Bearer " + accountToken, http.StatusOK, []string{"token", "session"}},
+		{"join with token in query", "/api/session/events?token=" + accountToken, "", http.StatusOK, []string{"token", "session"}},
+		{"join without token", "/api/session/events", "", http.StatusUnauthorized, nil},
+		{"spectate finished game", "/api/session/spectate?session_id=finished", "", http.StatusOK, []string{"session", "session"}},
+		{"spectate unknown session", "/api/session/spectate?session_id=unknown", "", http.StatusNotFound, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, gateway.URL+test.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.token != "" {
+				req.Header.Set("Authorization", test.token)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != test.expected {
+				t.Fatalf("expected status %d, got %d", test.expected, resp.StatusCode)
+			}
+			if resp.StatusCode != http.StatusOK {
+				return
+			}
+			if contentType := resp.Header.Get("Content-Type"); contentType != "text/event-stream" {
+				t.Fatalf("expected event stream, got %s", contentType)
+			}
+
+			events := readEvents(t, resp.Body, len(test.events))
+			for i, name := range test.events {
+				if events[i].name != name {
+					t.Fatalf("expected %s event, got %v", name, events[i])
+				}
+			}
+			if events[0].name == "token" {
+				token := map[string]string{}
+				err = json.Unmarshal([]byte(events[0].data), &token)
+				if err != nil || token["token"] != playerToken {
+					t.Fatalf("expected player token, got %s", events[0].data)
+				}
+			}
+		})
+	}
+}
+
+func TestHTTPStatus(t *testing.T) {
+	tests := []struct {
+		code     codes.Code
+		expected int
+	}{
+		{codes.OK, http.StatusOK},
+		{codes.Unknown, http.StatusBadRequest},
+		{codes.FailedPrecondition, http.StatusBadRequest},
+		{codes.Unauthenticated, http.StatusUnauthorized},
+		{codes.PermissionDenied, http.StatusForbidden},
+		{codes.NotFound, http.StatusNotFound},
+		{codes.AlreadyExists, http.StatusConflict},
+		{codes.ResourceExhausted, http.StatusTooManyRequests},
+		{codes.Unavailable, http.StatusServiceUnavailable},
+		{codes.Internal, http.StatusInternalServerError},
+	}
+
+	for _, test := range tests {
+		t.Run(test.code.String(), func(t *testing.T) {
+			if status := HTTPStatus(test.code); status != test.expected {
+				t.Fatalf("expected %d, got %d", test.expected, status)
+			}
+		})
+	}
+}
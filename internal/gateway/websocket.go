@@ -0,0 +1,173 @@
+package gateway
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"soa_hw_2/internal/pb"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const maxActionSize = 64 * 1024
+
+var upgrader = websocket.Upgrader{
+	// browser frontends are served from other origins, requests are authorized by token, not cookies
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+type socket struct {
+	conn   *websocket.Conn
+	binary bool
+	mutex  sync.Mutex
+}
+
+func (s *socket) write(frame *pb.WebSocketFrame) error {
+	messageType := websocket.TextMessage
+	marshal := marshaler.Marshal
+	if s.binary {
+		messageType = websocket.BinaryMessage
+		marshal = proto.Marshal
+	}
+
+	data, err := marshal(frame)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.conn.WriteMessage(messageType, data)
+}
+
+func (s *socket) close(code int, text string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	_ = s.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(code, text))
+	_ = s.conn.Close()
+}
+
+// handleWebSocket joins a game like StartSession (or Resume, Spectate with `resume` and `spectate`
+// query parameters) and forwards session events as frames, while actions are read from the same socket.
+// Text frames carry JSON and binary frames carry protobuf, `format=proto` switches server frames to binary.
+func (g *Gateway) handleWebSocket(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(withToken(r))
+	defer cancel()
+
+	stream, md, event, err := openStream(ctx, r, func(ctx context.Context, r *http.Request) (eventStream, error) {
+		query := r.URL.Query()
+		switch {
+		case query.Get("spectate") != "":
+			return g.client.Spectate(ctx, &pb.SpectateRequest{SessionId: query.Get("spectate")})
+		case query.Get("resume") != "":
+			return g.client.Resume(ctx, &pb.ResumeRequest{SessionId: query.Get("resume")})
+		default:
+			return g.client.StartSession(ctx, &pb.StartSessionRequest{})
+		}
+	})
+	if err != nil && err != io.EOF {
+		writeStatus(w, err)
+		return
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	conn.SetReadLimit(maxActionSize)
+	s := &socket{conn: conn, binary: r.URL.Query().Get("format") == "proto"}
+
+	actionCtx := r.Context()
+	token, tokenErr := pb.FetchToken(md)
+	if tokenErr == nil {
+		actionCtx = metadata.NewOutgoingContext(actionCtx, pb.WithToken(token))
+		_ = s.write(&pb.WebSocketFrame{Frame: &pb.WebSocketFrame_Token{Token: token}})
+	}
+
+	go forwardEvents(ctx, s, stream, event, err)
+
+	for {
+		messageType, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		action := &pb.WebSocketAction{}
+		switch messageType {
+		case websocket.BinaryMessage:
+			err = proto.Unmarshal(data, action)
+		default:
+			err = unmarshaler.Unmarshal(data, action)
+		}
+		if err != nil {
+			err = status.Errorf(codes.InvalidArgument, "invalid action: %s", err)
+			_ = s.write(&pb.WebSocketFrame{Frame: errorFrame(err)})
+			continue
+		}
+
+		frame := g.dispatch(actionCtx, action)
+		frame.RequestId = action.RequestId
+		err = s.write(frame)
+		if err != nil {
+			return
+		}
+	}
+}
+
+func forwardEvents(ctx context.Context, s *socket, stream eventStream, event *pb.SessionEvent, err error) {
+	for err == nil {
+		err = s.write(&pb.WebSocketFrame{Frame: &pb.WebSocketFrame_Event{Event: event}})
+		if err == nil {
+			event, err = stream.Recv()
+		}
+	}
+	if err == io.EOF || ctx.Err() != nil {
+		s.close(websocket.CloseNormalClosure, "session is closed")
+		return
+	}
+	_ = s.write(&pb.WebSocketFrame{Frame: errorFrame(err)})
+	s.close(websocket.CloseInternalServerErr, status.Convert(err).Message())
+}
+
+func (g *Gateway) dispatch(ctx context.Context, action *pb.WebSocketAction) *pb.WebSocketFrame {
+	var frame *pb.WebSocketFrame
+	var err error
+	switch a := action.Action.(type) {
+	case *pb.WebSocketAction_Vote:
+		_, err = g.client.Vote(ctx, a.Vote)
+	case *pb.WebSocketAction_Check:
+		var resp *pb.CheckResponse
+		resp, err = g.client.Check(ctx, a.Check)
+		frame = &pb.WebSocketFrame{Frame: &pb.WebSocketFrame_CheckResult{CheckResult: resp}}
+	case *pb.WebSocketAction_Ready:
+		_, err = g.client.SetReady(ctx, a.Ready)
+	case *pb.WebSocketAction_StartGame:
+		_, err = g.client.StartGame(ctx, &pb.Empty{})
+	case *pb.WebSocketAction_Message:
+		_, err = g.client.SendMessage(ctx, a.Message)
+	case *pb.WebSocketAction_GetState:
+		var state *pb.SessionState
+		state, err = g.client.GetSessionState(ctx, &pb.Empty{})
+		frame = &pb.WebSocketFrame{Frame: &pb.WebSocketFrame_State{State: state}}
+	default:
+		err = status.Error(codes.InvalidArgument, "unknown action")
+	}
+
+	if err != nil {
+		return &pb.WebSocketFrame{Frame: errorFrame(err)}
+	}
+	if frame == nil {
+		frame = &pb.WebSocketFrame{Frame: &pb.WebSocketFrame_Ok{Ok: &pb.Empty{}}}
+	}
+	return frame
+}
+
+func errorFrame(err error) *pb.WebSocketFrame_Error_ {
+	st := status.Convert(err)
+	return &pb.WebSocketFrame_Error_{Error: &pb.WebSocketFrame_Error{Code: st.Code().String(), Message: st.Message()}}
+}
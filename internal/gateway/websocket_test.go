@@ -0,0 +1,166 @@
+package gateway
+
+import (
+	"net/http"
+	"soa_hw_2/internal/pb"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func dial(t *testing.T, url string, token string) (*websocket.Conn, *http.Response, error) {
+	header := http.Header{}
+	if token != "" {
+		header.Set("Authorization", "Bearer "+token)
+	}
+	conn, resp, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(url, "http"), header)
+	if err == nil {
+		t.Cleanup(func() {
+			_ = conn.Close()
+		})
+	}
+	return conn, resp, err
+}
+
+func readFrame(t *testing.T, conn *websocket.Conn, binary bool) *pb.WebSocketFrame {
+	messageType, data, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	frame := &pb.WebSocketFrame{}
+	if binary {
+		if messageType != websocket.BinaryMessage {
+			t.Fatalf("expected binary frame, got %d", messageType)
+		}
+		err = proto.Unmarshal(data, frame)
+	} else {
+		if messageType != websocket.TextMessage {
+			t.Fatalf("expected text frame, got %d", messageType)
+		}
+		err = protojson.Unmarshal(data, frame)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return frame
+}
+
+func TestWebSocketActions(t *testing.T) {
+	gateway := serve(t)
+
+	tests := []struct {
+		name     string
+		action   string
+		expected func(frame *pb.WebSocketFrame) bool
+	}{
+		{"vote", `{"requestId": "1", "vote": {"username": "bob"}}`, func(frame *pb.WebSocketFrame) bool {
+			return frame.RequestId == "1" && frame.GetOk() != nil
+		}},
+		{"rejected vote", `{"requestId": "2", "vote": {}}`, func(frame *pb.WebSocketFrame) bool {
+			return frame.RequestId == "2" && frame.GetError().GetCode() == "InvalidArgument"
+		}},
+		{"check", `{"requestId": "3", "check": {"username": "bob"}}`, func(frame *pb.WebSocketFrame) bool {
+			return frame.RequestId == "3" && frame.GetCheckResult().GetRole() == pb.Role_MAFIA_ROLE
+		}},
+		{"get state", `{"requestId": "4", "getState": {}}`, func(frame *pb.WebSocketFrame) bool {
+			return frame.RequestId == "4" && frame.GetState().GetSessionId() == "session-id"
+		}},
+		{"unimplemented action", `{"requestId": "5", "startGame": {}}`, func(frame *pb.WebSocketFrame) bool {
+			return frame.RequestId == "5" && frame.GetError().GetCode() == "Unimplemented"
+		}},
+		{"unknown action", `{"requestId": "6"}`, func(frame *pb.WebSocketFrame) bool {
+			return frame.RequestId == "6" && frame.GetError().GetCode() == "InvalidArgument"
+		}},
+		{"malformed action", `{"vote": `, func(frame *pb.WebSocketFrame) bool {
+			return frame.GetError().GetCode() == "InvalidArgument"
+		}},
+	}
+
+	for _, binary := range []bool{false, true} {
+		format, url := "json", gateway.URL+"/api/ws"
+		if binary {
+			format, url = "proto", url+"?format=proto"
+		}
+
+		t.Run(format, func(t *testing.T) {
+			conn, _, err := dial(t, url, accountToken)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if token := readFrame(t, conn, binary).GetToken(); token != playerToken {
+				t.Fatalf("expected player token, got %q", token)
+			}
+			if join := readFrame(t, conn, binary).GetEvent().GetJoinInfo(); join.GetUsername() != "alice" {
+				t.Fatalf("expected join of alice, got %v", join)
+			}
+
+			for _, test := range tests {
+				t.Run(test.name, func(t *testing.T) {
+					messageType, data := websocket.TextMessage, []byte(test.action)
+					action := &pb.WebSocketAction{}
+					if binary && protojson.Unmarshal(data, action) == nil {
+						messageType = websocket.BinaryMessage
+						data, err = proto.Marshal(action)
+						if err != nil {
+							t.Fatal(err)
+						}
+					}
+
+					err = conn.WriteMessage(messageType, data)
+					if err != nil {
+						t.Fatal(err)
+					}
+					frame := readFrame(t, conn, binary)
+					if !test.expected(frame) {
+						t.Fatalf("unexpected frame %v", frame)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestWebSocketConnect(t *testing.T) {
+	gateway := serve(t)
+
+	tests := []struct {
+		name     string
+		query    string
+		token    string
+		expected int
+		events   int
+	}{
+		{"join without token", "", "", http.StatusUnauthorized, 0},
+		{"spectate unknown session", "?spectate=unknown", "", http.StatusNotFound, 0},
+		{"spectate finished game", "?spectate=finished", "", http.StatusSwitchingProtocols, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			conn, resp, err := dial(t, gateway.URL+"/api/ws"+test.query, test.token)
+			if resp == nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != test.expected {
+				t.Fatalf("expected status %d, got %d", test.expected, resp.StatusCode)
+			}
+			if conn == nil {
+				return
+			}
+
+			for i := 0; i < test.events; i++ {
+				if readFrame(t, conn, false).GetEvent() == nil {
+					t.Fatal("expected session event")
+				}
+			}
+			_, _, err = conn.ReadMessage()
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				t.Fatalf("expected normal closure, got %v", err)
+			}
+		})
+	}
+}
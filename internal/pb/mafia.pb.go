@@ -72,6 +72,211 @@ func (Role) EnumDescriptor() ([]byte, []int) {
 	return file_mafia_proto_rawDescGZIP(), []int{0}
 }
 
+type Phase int32
+
+const (
+	Phase_UNKNOWN_PHASE Phase = 0
+	Phase_DAY           Phase = 1
+	Phase_NIGHT         Phase = 2
+)
+
+// Enum value maps for Phase.
+var (
+	Phase_name = map[int32]string{
+		0: "UNKNOWN_PHASE",
+		1: "DAY",
+		2: "NIGHT",
+	}
+	Phase_value = map[string]int32{
+		"UNKNOWN_PHASE": 0,
+		"DAY":           1,
+		"NIGHT":         2,
+	}
+)
+
+func (x Phase) Enum() *Phase {
+	p := new(Phase)
+	*p = x
+	return p
+}
+
+func (x Phase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Phase) Descriptor() protoreflect.EnumDescriptor {
+	return file_mafia_proto_enumTypes[1].Descriptor()
+}
+
+func (Phase) Type() protoreflect.EnumType {
+	return &file_mafia_proto_enumTypes[1]
+}
+
+func (x Phase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Phase.Descriptor instead.
+func (Phase) EnumDescriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{1}
+}
+
+type ChatChannel int32
+
+const (
+	ChatChannel_PUBLIC_CHANNEL ChatChannel = 0
+	ChatChannel_DEAD_CHANNEL   ChatChannel = 1
+)
+
+// Enum value maps for ChatChannel.
+var (
+	ChatChannel_name = map[int32]string{
+		0: "PUBLIC_CHANNEL",
+		1: "DEAD_CHANNEL",
+	}
+	ChatChannel_value = map[string]int32{
+		"PUBLIC_CHANNEL": 0,
+		"DEAD_CHANNEL":   1,
+	}
+)
+
+func (x ChatChannel) Enum() *ChatChannel {
+	p := new(ChatChannel)
+	*p = x
+	return p
+}
+
+func (x ChatChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_mafia_proto_enumTypes[2].Descriptor()
+}
+
+func (ChatChannel) Type() protoreflect.EnumType {
+	return &file_mafia_proto_enumTypes[2]
+}
+
+func (x ChatChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatChannel.Descriptor instead.
+func (ChatChannel) EnumDescriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{2}
+}
+
+type NightAction int32
+
+const (
+	NightAction_UNKNOWN_ACTION NightAction = 0
+	NightAction_MAFIA_VOTE     NightAction = 1
+	NightAction_SHERIFF_CHECK  NightAction = 2
+)
+
+// Enum value maps for NightAction.
+var (
+	NightAction_name = map[int32]string{
+		0: "UNKNOWN_ACTION",
+		1: "MAFIA_VOTE",
+		2: "SHERIFF_CHECK",
+	}
+	NightAction_value = map[string]int32{
+		"UNKNOWN_ACTION": 0,
+		"MAFIA_VOTE":     1,
+		"SHERIFF_CHECK":  2,
+	}
+)
+
+func (x NightAction) Enum() *NightAction {
+	p := new(NightAction)
+	*p = x
+	return p
+}
+
+func (x NightAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NightAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_mafia_proto_enumTypes[3].Descriptor()
+}
+
+func (NightAction) Type() protoreflect.EnumType {
+	return &file_mafia_proto_enumTypes[3]
+}
+
+func (x NightAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NightAction.Descriptor instead.
+func (NightAction) EnumDescriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{3}
+}
+
+type ModeratorAction int32
+
+const (
+	ModeratorAction_UNKNOWN_MODERATOR_ACTION ModeratorAction = 0
+	ModeratorAction_PAUSE_TIMER              ModeratorAction = 1
+	ModeratorAction_RESUME_TIMER             ModeratorAction = 2
+	ModeratorAction_ADVANCE_PHASE            ModeratorAction = 3
+	ModeratorAction_KILL_PLAYER              ModeratorAction = 4
+	ModeratorAction_REVIVE_PLAYER            ModeratorAction = 5
+	ModeratorAction_KICK_PLAYER              ModeratorAction = 6
+)
+
+// Enum value maps for ModeratorAction.
+var (
+	ModeratorAction_name = map[int32]string{
+		0: "UNKNOWN_MODERATOR_ACTION",
+		1: "PAUSE_TIMER",
+		2: "RESUME_TIMER",
+		3: "ADVANCE_PHASE",
+		4: "KILL_PLAYER",
+		5: "REVIVE_PLAYER",
+		6: "KICK_PLAYER",
+	}
+	ModeratorAction_value = map[string]int32{
+		"UNKNOWN_MODERATOR_ACTION": 0,
+		"PAUSE_TIMER":              1,
+		"RESUME_TIMER":             2,
+		"ADVANCE_PHASE":            3,
+		"KILL_PLAYER":              4,
+		"REVIVE_PLAYER":            5,
+		"KICK_PLAYER":              6,
+	}
+)
+
+func (x ModeratorAction) Enum() *ModeratorAction {
+	p := new(ModeratorAction)
+	*p = x
+	return p
+}
+
+func (x ModeratorAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModeratorAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_mafia_proto_enumTypes[4].Descriptor()
+}
+
+func (ModeratorAction) Type() protoreflect.EnumType {
+	return &file_mafia_proto_enumTypes[4]
+}
+
+func (x ModeratorAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModeratorAction.Descriptor instead.
+func (ModeratorAction) EnumDescriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{4}
+}
+
 type Team int32
 
 const (
@@ -105,11 +310,11 @@ func (x Team) String() string {
 }
 
 func (Team) Descriptor() protoreflect.EnumDescriptor {
-	return file_mafia_proto_enumTypes[1].Descriptor()
+	return file_mafia_proto_enumTypes[5].Descriptor()
 }
 
 func (Team) Type() protoreflect.EnumType {
-	return &file_mafia_proto_enumTypes[1]
+	return &file_mafia_proto_enumTypes[5]
 }
 
 func (x Team) Number() protoreflect.EnumNumber {
@@ -118,7 +323,7 @@ func (x Team) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Team.Descriptor instead.
 func (Team) EnumDescriptor() ([]byte, []int) {
-	return file_mafia_proto_rawDescGZIP(), []int{1}
+	return file_mafia_proto_rawDescGZIP(), []int{5}
 }
 
 type Empty struct {
@@ -159,16 +364,17 @@ func (*Empty) Descriptor() ([]byte, []int) {
 	return file_mafia_proto_rawDescGZIP(), []int{0}
 }
 
-type StartSessionRequest struct {
+type AccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *StartSessionRequest) Reset() {
-	*x = StartSessionRequest{}
+func (x *AccountRequest) Reset() {
+	*x = AccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mafia_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -176,13 +382,13 @@ func (x *StartSessionRequest) Reset() {
 	}
 }
 
-func (x *StartSessionRequest) String() string {
+func (x *AccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartSessionRequest) ProtoMessage() {}
+func (*AccountRequest) ProtoMessage() {}
 
-func (x *StartSessionRequest) ProtoReflect() protoreflect.Message {
+func (x *AccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mafia_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -194,28 +400,36 @@ func (x *StartSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartSessionRequest.ProtoReflect.Descriptor instead.
-func (*StartSessionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountRequest.ProtoReflect.Descriptor instead.
+func (*AccountRequest) Descriptor() ([]byte, []int) {
 	return file_mafia_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *StartSessionRequest) GetUsername() string {
+func (x *AccountRequest) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-type VoteRequest struct {
+func (x *AccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type AccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	AccountId string `protobuf:"bytes,1,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	Token     string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *VoteRequest) Reset() {
-	*x = VoteRequest{}
+func (x *AccountResponse) Reset() {
+	*x = AccountResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mafia_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -223,13 +437,13 @@ func (x *VoteRequest) Reset() {
 	}
 }
 
-func (x *VoteRequest) String() string {
+func (x *AccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VoteRequest) ProtoMessage() {}
+func (*AccountResponse) ProtoMessage() {}
 
-func (x *VoteRequest) ProtoReflect() protoreflect.Message {
+func (x *AccountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_mafia_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -241,28 +455,33 @@ func (x *VoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VoteRequest.ProtoReflect.Descriptor instead.
-func (*VoteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountResponse.ProtoReflect.Descriptor instead.
+func (*AccountResponse) Descriptor() ([]byte, []int) {
 	return file_mafia_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *VoteRequest) GetUsername() string {
+func (x *AccountResponse) GetAccountId() string {
 	if x != nil {
-		return x.Username
+		return x.AccountId
 	}
 	return ""
 }
 
-type ShootRequest struct {
+func (x *AccountResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type StartSessionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *ShootRequest) Reset() {
-	*x = ShootRequest{}
+func (x *StartSessionRequest) Reset() {
+	*x = StartSessionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mafia_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -270,13 +489,13 @@ func (x *ShootRequest) Reset() {
 	}
 }
 
-func (x *ShootRequest) String() string {
+func (x *StartSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShootRequest) ProtoMessage() {}
+func (*StartSessionRequest) ProtoMessage() {}
 
-func (x *ShootRequest) ProtoReflect() protoreflect.Message {
+func (x *StartSessionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mafia_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -288,28 +507,21 @@ func (x *ShootRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShootRequest.ProtoReflect.Descriptor instead.
-func (*ShootRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartSessionRequest.ProtoReflect.Descriptor instead.
+func (*StartSessionRequest) Descriptor() ([]byte, []int) {
 	return file_mafia_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ShootRequest) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
-}
-
-type CheckRequest struct {
+type ResumeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	SessionId string `protobuf:"bytes,1,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
 }
 
-func (x *CheckRequest) Reset() {
-	*x = CheckRequest{}
+func (x *ResumeRequest) Reset() {
+	*x = ResumeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mafia_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -317,13 +529,13 @@ func (x *CheckRequest) Reset() {
 	}
 }
 
-func (x *CheckRequest) String() string {
+func (x *ResumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckRequest) ProtoMessage() {}
+func (*ResumeRequest) ProtoMessage() {}
 
-func (x *CheckRequest) ProtoReflect() protoreflect.Message {
+func (x *ResumeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mafia_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -335,29 +547,28 @@ func (x *CheckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckRequest.ProtoReflect.Descriptor instead.
-func (*CheckRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResumeRequest.ProtoReflect.Descriptor instead.
+func (*ResumeRequest) Descriptor() ([]byte, []int) {
 	return file_mafia_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CheckRequest) GetUsername() string {
+func (x *ResumeRequest) GetSessionId() string {
 	if x != nil {
-		return x.Username
+		return x.SessionId
 	}
 	return ""
 }
 
-type CheckResponse struct {
+type GameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Role     Role   `protobuf:"varint,2,opt,name=role,proto3,enum=mafia.Role" json:"role,omitempty"`
+	GameId string `protobuf:"bytes,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
 }
 
-func (x *CheckResponse) Reset() {
-	*x = CheckResponse{}
+func (x *GameRequest) Reset() {
+	*x = GameRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mafia_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -365,13 +576,13 @@ func (x *CheckResponse) Reset() {
 	}
 }
 
-func (x *CheckResponse) String() string {
+func (x *GameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckResponse) ProtoMessage() {}
+func (*GameRequest) ProtoMessage() {}
 
-func (x *CheckResponse) ProtoReflect() protoreflect.Message {
+func (x *GameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mafia_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -383,37 +594,28 @@ func (x *CheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckResponse.ProtoReflect.Descriptor instead.
-func (*CheckResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GameRequest.ProtoReflect.Descriptor instead.
+func (*GameRequest) Descriptor() ([]byte, []int) {
 	return file_mafia_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CheckResponse) GetUsername() string {
+func (x *GameRequest) GetGameId() string {
 	if x != nil {
-		return x.Username
+		return x.GameId
 	}
 	return ""
 }
 
-func (x *CheckResponse) GetRole() Role {
-	if x != nil {
-		return x.Role
-	}
-	return Role_UNKNOWN_ROLE
-}
-
-type Player struct {
+type PlayerStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Role     Role   `protobuf:"varint,1,opt,name=role,proto3,enum=mafia.Role" json:"role,omitempty"`
-	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Liveness bool   `protobuf:"varint,3,opt,name=liveness,proto3" json:"liveness,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *Player) Reset() {
-	*x = Player{}
+func (x *PlayerStatsRequest) Reset() {
+	*x = PlayerStatsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mafia_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -421,13 +623,13 @@ func (x *Player) Reset() {
 	}
 }
 
-func (x *Player) String() string {
+func (x *PlayerStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Player) ProtoMessage() {}
+func (*PlayerStatsRequest) ProtoMessage() {}
 
-func (x *Player) ProtoReflect() protoreflect.Message {
+func (x *PlayerStatsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mafia_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -439,44 +641,28 @@ func (x *Player) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Player.ProtoReflect.Descriptor instead.
-func (*Player) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlayerStatsRequest.ProtoReflect.Descriptor instead.
+func (*PlayerStatsRequest) Descriptor() ([]byte, []int) {
 	return file_mafia_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Player) GetRole() Role {
-	if x != nil {
-		return x.Role
-	}
-	return Role_UNKNOWN_ROLE
-}
-
-func (x *Player) GetUsername() string {
+func (x *PlayerStatsRequest) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *Player) GetLiveness() bool {
-	if x != nil {
-		return x.Liveness
-	}
-	return false
-}
-
-type SessionState struct {
+type LeaderboardRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Player     *Player   `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
-	Players    []*Player `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
-	WinnerTeam Team      `protobuf:"varint,3,opt,name=winnerTeam,proto3,enum=mafia.Team" json:"winnerTeam,omitempty"`
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *SessionState) Reset() {
-	*x = SessionState{}
+func (x *LeaderboardRequest) Reset() {
+	*x = LeaderboardRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mafia_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -484,13 +670,13 @@ func (x *SessionState) Reset() {
 	}
 }
 
-func (x *SessionState) String() string {
+func (x *LeaderboardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionState) ProtoMessage() {}
+func (*LeaderboardRequest) ProtoMessage() {}
 
-func (x *SessionState) ProtoReflect() protoreflect.Message {
+func (x *LeaderboardRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mafia_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -502,63 +688,42 @@ func (x *SessionState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionState.ProtoReflect.Descriptor instead.
-func (*SessionState) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*LeaderboardRequest) Descriptor() ([]byte, []int) {
 	return file_mafia_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *SessionState) GetPlayer() *Player {
+func (x *LeaderboardRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Player
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-func (x *SessionState) GetPlayers() []*Player {
-	if x != nil {
-		return x.Players
-	}
-	return nil
+type VoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *SessionState) GetWinnerTeam() Team {
-	if x != nil {
-		return x.WinnerTeam
+func (x *VoteRequest) Reset() {
+	*x = VoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return Team_UNKNOWN_TEAM
 }
 
-type SessionEvent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to EventInfo:
-	//
-	//	*SessionEvent_StartInfo
-	//	*SessionEvent_FinishInfo
-	//	*SessionEvent_JoinInfo
-	//	*SessionEvent_LeftInfo
-	//	*SessionEvent_VoteInfo_
-	EventInfo isSessionEvent_EventInfo `protobuf_oneof:"eventInfo"`
-}
-
-func (x *SessionEvent) Reset() {
-	*x = SessionEvent{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_mafia_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *SessionEvent) String() string {
+func (x *VoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionEvent) ProtoMessage() {}
+func (*VoteRequest) ProtoMessage() {}
 
-func (x *SessionEvent) ProtoReflect() protoreflect.Message {
+func (x *VoteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mafia_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -570,113 +735,137 @@ func (x *SessionEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionEvent.ProtoReflect.Descriptor instead.
-func (*SessionEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use VoteRequest.ProtoReflect.Descriptor instead.
+func (*VoteRequest) Descriptor() ([]byte, []int) {
 	return file_mafia_proto_rawDescGZIP(), []int{8}
 }
 
-func (m *SessionEvent) GetEventInfo() isSessionEvent_EventInfo {
-	if m != nil {
-		return m.EventInfo
+func (x *VoteRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-func (x *SessionEvent) GetStartInfo() *SessionEvent_SessionStartInfo {
-	if x, ok := x.GetEventInfo().(*SessionEvent_StartInfo); ok {
-		return x.StartInfo
-	}
-	return nil
-}
+type ShootRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *SessionEvent) GetFinishInfo() *SessionEvent_SessionFinishInfo {
-	if x, ok := x.GetEventInfo().(*SessionEvent_FinishInfo); ok {
-		return x.FinishInfo
-	}
-	return nil
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *SessionEvent) GetJoinInfo() *SessionEvent_PlayerJoinInfo {
-	if x, ok := x.GetEventInfo().(*SessionEvent_JoinInfo); ok {
-		return x.JoinInfo
+func (x *ShootRequest) Reset() {
+	*x = ShootRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *SessionEvent) GetLeftInfo() *SessionEvent_PlayerLeftInfo {
-	if x, ok := x.GetEventInfo().(*SessionEvent_LeftInfo); ok {
-		return x.LeftInfo
-	}
-	return nil
+func (x *ShootRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SessionEvent) GetVoteInfo() *SessionEvent_VoteInfo {
-	if x, ok := x.GetEventInfo().(*SessionEvent_VoteInfo_); ok {
-		return x.VoteInfo
+func (*ShootRequest) ProtoMessage() {}
+
+func (x *ShootRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type isSessionEvent_EventInfo interface {
-	isSessionEvent_EventInfo()
+// Deprecated: Use ShootRequest.ProtoReflect.Descriptor instead.
+func (*ShootRequest) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{9}
 }
 
-type SessionEvent_StartInfo struct {
-	StartInfo *SessionEvent_SessionStartInfo `protobuf:"bytes,1,opt,name=startInfo,proto3,oneof"`
+func (x *ShootRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
 }
 
-type SessionEvent_FinishInfo struct {
-	FinishInfo *SessionEvent_SessionFinishInfo `protobuf:"bytes,2,opt,name=finishInfo,proto3,oneof"`
-}
+type ReadyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type SessionEvent_JoinInfo struct {
-	JoinInfo *SessionEvent_PlayerJoinInfo `protobuf:"bytes,3,opt,name=joinInfo,proto3,oneof"`
+	Ready bool `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
 }
 
-type SessionEvent_LeftInfo struct {
-	LeftInfo *SessionEvent_PlayerLeftInfo `protobuf:"bytes,4,opt,name=leftInfo,proto3,oneof"`
+func (x *ReadyRequest) Reset() {
+	*x = ReadyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type SessionEvent_VoteInfo_ struct {
-	VoteInfo *SessionEvent_VoteInfo `protobuf:"bytes,5,opt,name=voteInfo,proto3,oneof"`
+func (x *ReadyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionEvent_StartInfo) isSessionEvent_EventInfo() {}
-
-func (*SessionEvent_FinishInfo) isSessionEvent_EventInfo() {}
+func (*ReadyRequest) ProtoMessage() {}
 
-func (*SessionEvent_JoinInfo) isSessionEvent_EventInfo() {}
+func (x *ReadyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-func (*SessionEvent_LeftInfo) isSessionEvent_EventInfo() {}
+// Deprecated: Use ReadyRequest.ProtoReflect.Descriptor instead.
+func (*ReadyRequest) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{10}
+}
 
-func (*SessionEvent_VoteInfo_) isSessionEvent_EventInfo() {}
+func (x *ReadyRequest) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
 
-type SessionEvent_SessionStartInfo struct {
+type ChatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Role    Role      `protobuf:"varint,1,opt,name=role,proto3,enum=mafia.Role" json:"role,omitempty"`
-	Players []*Player `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
 }
 
-func (x *SessionEvent_SessionStartInfo) Reset() {
-	*x = SessionEvent_SessionStartInfo{}
+func (x *ChatRequest) Reset() {
+	*x = ChatRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mafia_proto_msgTypes[9]
+		mi := &file_mafia_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SessionEvent_SessionStartInfo) String() string {
+func (x *ChatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionEvent_SessionStartInfo) ProtoMessage() {}
+func (*ChatRequest) ProtoMessage() {}
 
-func (x *SessionEvent_SessionStartInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_mafia_proto_msgTypes[9]
+func (x *ChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -687,51 +876,43 @@ func (x *SessionEvent_SessionStartInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionEvent_SessionStartInfo.ProtoReflect.Descriptor instead.
-func (*SessionEvent_SessionStartInfo) Descriptor() ([]byte, []int) {
-	return file_mafia_proto_rawDescGZIP(), []int{8, 0}
-}
-
-func (x *SessionEvent_SessionStartInfo) GetRole() Role {
-	if x != nil {
-		return x.Role
-	}
-	return Role_UNKNOWN_ROLE
+// Deprecated: Use ChatRequest.ProtoReflect.Descriptor instead.
+func (*ChatRequest) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SessionEvent_SessionStartInfo) GetPlayers() []*Player {
+func (x *ChatRequest) GetText() string {
 	if x != nil {
-		return x.Players
+		return x.Text
 	}
-	return nil
+	return ""
 }
 
-type SessionEvent_SessionFinishInfo struct {
+type SpectateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Winners Team      `protobuf:"varint,1,opt,name=winners,proto3,enum=mafia.Team" json:"winners,omitempty"`
-	Players []*Player `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+	SessionId string `protobuf:"bytes,1,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
 }
 
-func (x *SessionEvent_SessionFinishInfo) Reset() {
-	*x = SessionEvent_SessionFinishInfo{}
+func (x *SpectateRequest) Reset() {
+	*x = SpectateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mafia_proto_msgTypes[10]
+		mi := &file_mafia_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SessionEvent_SessionFinishInfo) String() string {
+func (x *SpectateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionEvent_SessionFinishInfo) ProtoMessage() {}
+func (*SpectateRequest) ProtoMessage() {}
 
-func (x *SessionEvent_SessionFinishInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_mafia_proto_msgTypes[10]
+func (x *SpectateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -742,50 +923,43 @@ func (x *SessionEvent_SessionFinishInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionEvent_SessionFinishInfo.ProtoReflect.Descriptor instead.
-func (*SessionEvent_SessionFinishInfo) Descriptor() ([]byte, []int) {
-	return file_mafia_proto_rawDescGZIP(), []int{8, 1}
-}
-
-func (x *SessionEvent_SessionFinishInfo) GetWinners() Team {
-	if x != nil {
-		return x.Winners
-	}
-	return Team_UNKNOWN_TEAM
+// Deprecated: Use SpectateRequest.ProtoReflect.Descriptor instead.
+func (*SpectateRequest) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SessionEvent_SessionFinishInfo) GetPlayers() []*Player {
+func (x *SpectateRequest) GetSessionId() string {
 	if x != nil {
-		return x.Players
+		return x.SessionId
 	}
-	return nil
+	return ""
 }
 
-type SessionEvent_PlayerJoinInfo struct {
+type ModerateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	SessionId string `protobuf:"bytes,1,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
 }
 
-func (x *SessionEvent_PlayerJoinInfo) Reset() {
-	*x = SessionEvent_PlayerJoinInfo{}
+func (x *ModerateRequest) Reset() {
+	*x = ModerateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mafia_proto_msgTypes[11]
+		mi := &file_mafia_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SessionEvent_PlayerJoinInfo) String() string {
+func (x *ModerateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionEvent_PlayerJoinInfo) ProtoMessage() {}
+func (*ModerateRequest) ProtoMessage() {}
 
-func (x *SessionEvent_PlayerJoinInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_mafia_proto_msgTypes[11]
+func (x *ModerateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -796,19 +970,19 @@ func (x *SessionEvent_PlayerJoinInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionEvent_PlayerJoinInfo.ProtoReflect.Descriptor instead.
-func (*SessionEvent_PlayerJoinInfo) Descriptor() ([]byte, []int) {
-	return file_mafia_proto_rawDescGZIP(), []int{8, 2}
+// Deprecated: Use ModerateRequest.ProtoReflect.Descriptor instead.
+func (*ModerateRequest) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *SessionEvent_PlayerJoinInfo) GetUsername() string {
+func (x *ModerateRequest) GetSessionId() string {
 	if x != nil {
-		return x.Username
+		return x.SessionId
 	}
 	return ""
 }
 
-type SessionEvent_PlayerLeftInfo struct {
+type ModeratorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -816,23 +990,23 @@ type SessionEvent_PlayerLeftInfo struct {
 	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *SessionEvent_PlayerLeftInfo) Reset() {
-	*x = SessionEvent_PlayerLeftInfo{}
+func (x *ModeratorRequest) Reset() {
+	*x = ModeratorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mafia_proto_msgTypes[12]
+		mi := &file_mafia_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SessionEvent_PlayerLeftInfo) String() string {
+func (x *ModeratorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionEvent_PlayerLeftInfo) ProtoMessage() {}
+func (*ModeratorRequest) ProtoMessage() {}
 
-func (x *SessionEvent_PlayerLeftInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_mafia_proto_msgTypes[12]
+func (x *ModeratorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,19 +1017,19 @@ func (x *SessionEvent_PlayerLeftInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionEvent_PlayerLeftInfo.ProtoReflect.Descriptor instead.
-func (*SessionEvent_PlayerLeftInfo) Descriptor() ([]byte, []int) {
-	return file_mafia_proto_rawDescGZIP(), []int{8, 3}
+// Deprecated: Use ModeratorRequest.ProtoReflect.Descriptor instead.
+func (*ModeratorRequest) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *SessionEvent_PlayerLeftInfo) GetUsername() string {
+func (x *ModeratorRequest) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-type SessionEvent_VoteInfo struct {
+type CheckRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -863,23 +1037,23 @@ type SessionEvent_VoteInfo struct {
 	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *SessionEvent_VoteInfo) Reset() {
-	*x = SessionEvent_VoteInfo{}
+func (x *CheckRequest) Reset() {
+	*x = CheckRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mafia_proto_msgTypes[13]
+		mi := &file_mafia_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SessionEvent_VoteInfo) String() string {
+func (x *CheckRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionEvent_VoteInfo) ProtoMessage() {}
+func (*CheckRequest) ProtoMessage() {}
 
-func (x *SessionEvent_VoteInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_mafia_proto_msgTypes[13]
+func (x *CheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,43 +1064,44 @@ func (x *SessionEvent_VoteInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionEvent_VoteInfo.ProtoReflect.Descriptor instead.
-func (*SessionEvent_VoteInfo) Descriptor() ([]byte, []int) {
-	return file_mafia_proto_rawDescGZIP(), []int{8, 4}
+// Deprecated: Use CheckRequest.ProtoReflect.Descriptor instead.
+func (*CheckRequest) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *SessionEvent_VoteInfo) GetUsername() string {
+func (x *CheckRequest) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-type SessionEvent_ShootInfo struct {
+type CheckResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Role     Role   `protobuf:"varint,2,opt,name=role,proto3,enum=mafia.Role" json:"role,omitempty"`
 }
 
-func (x *SessionEvent_ShootInfo) Reset() {
-	*x = SessionEvent_ShootInfo{}
+func (x *CheckResponse) Reset() {
+	*x = CheckResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mafia_proto_msgTypes[14]
+		mi := &file_mafia_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SessionEvent_ShootInfo) String() string {
+func (x *CheckResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionEvent_ShootInfo) ProtoMessage() {}
+func (*CheckResponse) ProtoMessage() {}
 
-func (x *SessionEvent_ShootInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_mafia_proto_msgTypes[14]
+func (x *CheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -937,123 +1112,3436 @@ func (x *SessionEvent_ShootInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionEvent_ShootInfo.ProtoReflect.Descriptor instead.
-func (*SessionEvent_ShootInfo) Descriptor() ([]byte, []int) {
-	return file_mafia_proto_rawDescGZIP(), []int{8, 5}
+// Deprecated: Use CheckResponse.ProtoReflect.Descriptor instead.
+func (*CheckResponse) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SessionEvent_ShootInfo) GetUsername() string {
+func (x *CheckResponse) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-var File_mafia_proto protoreflect.FileDescriptor
+func (x *CheckResponse) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_UNKNOWN_ROLE
+}
 
-var file_mafia_proto_rawDesc = []byte{
+type Player struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role     Role   `protobuf:"varint,1,opt,name=role,proto3,enum=mafia.Role" json:"role,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Liveness bool   `protobuf:"varint,3,opt,name=liveness,proto3" json:"liveness,omitempty"`
+}
+
+func (x *Player) Reset() {
+	*x = Player{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Player) ProtoMessage() {}
+
+func (x *Player) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Player.ProtoReflect.Descriptor instead.
+func (*Player) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Player) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_UNKNOWN_ROLE
+}
+
+func (x *Player) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Player) GetLiveness() bool {
+	if x != nil {
+		return x.Liveness
+	}
+	return false
+}
+
+type SessionState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player     *Player   `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	Players    []*Player `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+	WinnerTeam Team      `protobuf:"varint,3,opt,name=winnerTeam,proto3,enum=mafia.Team" json:"winnerTeam,omitempty"`
+	SessionId  string    `protobuf:"bytes,4,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	Phase      Phase     `protobuf:"varint,5,opt,name=phase,proto3,enum=mafia.Phase" json:"phase,omitempty"`
+}
+
+func (x *SessionState) Reset() {
+	*x = SessionState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionState) ProtoMessage() {}
+
+func (x *SessionState) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionState.ProtoReflect.Descriptor instead.
+func (*SessionState) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SessionState) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *SessionState) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *SessionState) GetWinnerTeam() Team {
+	if x != nil {
+		return x.WinnerTeam
+	}
+	return Team_UNKNOWN_TEAM
+}
+
+func (x *SessionState) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionState) GetPhase() Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return Phase_UNKNOWN_PHASE
+}
+
+type SessionEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to EventInfo:
+	//	*SessionEvent_StartInfo
+	//	*SessionEvent_FinishInfo
+	//	*SessionEvent_JoinInfo
+	//	*SessionEvent_LeftInfo
+	//	*SessionEvent_VoteInfo_
+	//	*SessionEvent_LobbyUpdate
+	//	*SessionEvent_PhaseInfo_
+	//	*SessionEvent_ChatInfo_
+	//	*SessionEvent_NightActionInfo_
+	//	*SessionEvent_ModeratorActionInfo_
+	//	*SessionEvent_ShutdownInfo
+	EventInfo isSessionEvent_EventInfo `protobuf_oneof:"eventInfo"`
+}
+
+func (x *SessionEvent) Reset() {
+	*x = SessionEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEvent) ProtoMessage() {}
+
+func (x *SessionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEvent.ProtoReflect.Descriptor instead.
+func (*SessionEvent) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{19}
+}
+
+func (m *SessionEvent) GetEventInfo() isSessionEvent_EventInfo {
+	if m != nil {
+		return m.EventInfo
+	}
+	return nil
+}
+
+func (x *SessionEvent) GetStartInfo() *SessionEvent_SessionStartInfo {
+	if x, ok := x.GetEventInfo().(*SessionEvent_StartInfo); ok {
+		return x.StartInfo
+	}
+	return nil
+}
+
+func (x *SessionEvent) GetFinishInfo() *SessionEvent_SessionFinishInfo {
+	if x, ok := x.GetEventInfo().(*SessionEvent_FinishInfo); ok {
+		return x.FinishInfo
+	}
+	return nil
+}
+
+func (x *SessionEvent) GetJoinInfo() *SessionEvent_PlayerJoinInfo {
+	if x, ok := x.GetEventInfo().(*SessionEvent_JoinInfo); ok {
+		return x.JoinInfo
+	}
+	return nil
+}
+
+func (x *SessionEvent) GetLeftInfo() *SessionEvent_PlayerLeftInfo {
+	if x, ok := x.GetEventInfo().(*SessionEvent_LeftInfo); ok {
+		return x.LeftInfo
+	}
+	return nil
+}
+
+func (x *SessionEvent) GetVoteInfo() *SessionEvent_VoteInfo {
+	if x, ok := x.GetEventInfo().(*SessionEvent_VoteInfo_); ok {
+		return x.VoteInfo
+	}
+	return nil
+}
+
+func (x *SessionEvent) GetLobbyUpdate() *SessionEvent_LobbyUpdateInfo {
+	if x, ok := x.GetEventInfo().(*SessionEvent_LobbyUpdate); ok {
+		return x.LobbyUpdate
+	}
+	return nil
+}
+
+func (x *SessionEvent) GetPhaseInfo() *SessionEvent_PhaseInfo {
+	if x, ok := x.GetEventInfo().(*SessionEvent_PhaseInfo_); ok {
+		return x.PhaseInfo
+	}
+	return nil
+}
+
+func (x *SessionEvent) GetChatInfo() *SessionEvent_ChatInfo {
+	if x, ok := x.GetEventInfo().(*SessionEvent_ChatInfo_); ok {
+		return x.ChatInfo
+	}
+	return nil
+}
+
+func (x *SessionEvent) GetNightActionInfo() *SessionEvent_NightActionInfo {
+	if x, ok := x.GetEventInfo().(*SessionEvent_NightActionInfo_); ok {
+		return x.NightActionInfo
+	}
+	return nil
+}
+
+func (x *SessionEvent) GetModeratorActionInfo() *SessionEvent_ModeratorActionInfo {
+	if x, ok := x.GetEventInfo().(*SessionEvent_ModeratorActionInfo_); ok {
+		return x.ModeratorActionInfo
+	}
+	return nil
+}
+
+func (x *SessionEvent) GetShutdownInfo() *SessionEvent_ServerShutdownInfo {
+	if x, ok := x.GetEventInfo().(*SessionEvent_ShutdownInfo); ok {
+		return x.ShutdownInfo
+	}
+	return nil
+}
+
+type isSessionEvent_EventInfo interface {
+	isSessionEvent_EventInfo()
+}
+
+type SessionEvent_StartInfo struct {
+	StartInfo *SessionEvent_SessionStartInfo `protobuf:"bytes,1,opt,name=startInfo,proto3,oneof"`
+}
+
+type SessionEvent_FinishInfo struct {
+	FinishInfo *SessionEvent_SessionFinishInfo `protobuf:"bytes,2,opt,name=finishInfo,proto3,oneof"`
+}
+
+type SessionEvent_JoinInfo struct {
+	JoinInfo *SessionEvent_PlayerJoinInfo `protobuf:"bytes,3,opt,name=joinInfo,proto3,oneof"`
+}
+
+type SessionEvent_LeftInfo struct {
+	LeftInfo *SessionEvent_PlayerLeftInfo `protobuf:"bytes,4,opt,name=leftInfo,proto3,oneof"`
+}
+
+type SessionEvent_VoteInfo_ struct {
+	VoteInfo *SessionEvent_VoteInfo `protobuf:"bytes,5,opt,name=voteInfo,proto3,oneof"`
+}
+
+type SessionEvent_LobbyUpdate struct {
+	LobbyUpdate *SessionEvent_LobbyUpdateInfo `protobuf:"bytes,6,opt,name=lobbyUpdate,proto3,oneof"`
+}
+
+type SessionEvent_PhaseInfo_ struct {
+	PhaseInfo *SessionEvent_PhaseInfo `protobuf:"bytes,7,opt,name=phaseInfo,proto3,oneof"`
+}
+
+type SessionEvent_ChatInfo_ struct {
+	ChatInfo *SessionEvent_ChatInfo `protobuf:"bytes,8,opt,name=chatInfo,proto3,oneof"`
+}
+
+type SessionEvent_NightActionInfo_ struct {
+	NightActionInfo *SessionEvent_NightActionInfo `protobuf:"bytes,9,opt,name=nightActionInfo,proto3,oneof"`
+}
+
+type SessionEvent_ModeratorActionInfo_ struct {
+	ModeratorActionInfo *SessionEvent_ModeratorActionInfo `protobuf:"bytes,10,opt,name=moderatorActionInfo,proto3,oneof"`
+}
+
+type SessionEvent_ShutdownInfo struct {
+	ShutdownInfo *SessionEvent_ServerShutdownInfo `protobuf:"bytes,11,opt,name=shutdownInfo,proto3,oneof"`
+}
+
+func (*SessionEvent_StartInfo) isSessionEvent_EventInfo() {}
+
+func (*SessionEvent_FinishInfo) isSessionEvent_EventInfo() {}
+
+func (*SessionEvent_JoinInfo) isSessionEvent_EventInfo() {}
+
+func (*SessionEvent_LeftInfo) isSessionEvent_EventInfo() {}
+
+func (*SessionEvent_VoteInfo_) isSessionEvent_EventInfo() {}
+
+func (*SessionEvent_LobbyUpdate) isSessionEvent_EventInfo() {}
+
+func (*SessionEvent_PhaseInfo_) isSessionEvent_EventInfo() {}
+
+func (*SessionEvent_ChatInfo_) isSessionEvent_EventInfo() {}
+
+func (*SessionEvent_NightActionInfo_) isSessionEvent_EventInfo() {}
+
+func (*SessionEvent_ModeratorActionInfo_) isSessionEvent_EventInfo() {}
+
+func (*SessionEvent_ShutdownInfo) isSessionEvent_EventInfo() {}
+
+type AdminSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+}
+
+func (x *AdminSessionRequest) Reset() {
+	*x = AdminSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSessionRequest) ProtoMessage() {}
+
+func (x *AdminSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSessionRequest.ProtoReflect.Descriptor instead.
+func (*AdminSessionRequest) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AdminSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type AdminKickRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	Username  string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *AdminKickRequest) Reset() {
+	*x = AdminKickRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminKickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminKickRequest) ProtoMessage() {}
+
+func (x *AdminKickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminKickRequest.ProtoReflect.Descriptor instead.
+func (*AdminKickRequest) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AdminKickRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *AdminKickRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type AdminModeratorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	Username  string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *AdminModeratorRequest) Reset() {
+	*x = AdminModeratorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminModeratorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminModeratorRequest) ProtoMessage() {}
+
+func (x *AdminModeratorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminModeratorRequest.ProtoReflect.Descriptor instead.
+func (*AdminModeratorRequest) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AdminModeratorRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *AdminModeratorRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type SessionSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId    string `protobuf:"bytes,1,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	IsStarted    bool   `protobuf:"varint,2,opt,name=isStarted,proto3" json:"isStarted,omitempty"`
+	IsEnded      bool   `protobuf:"varint,3,opt,name=isEnded,proto3" json:"isEnded,omitempty"`
+	Phase        Phase  `protobuf:"varint,4,opt,name=phase,proto3,enum=mafia.Phase" json:"phase,omitempty"`
+	PlayersCount int32  `protobuf:"varint,5,opt,name=playersCount,proto3" json:"playersCount,omitempty"`
+	AliveCount   int32  `protobuf:"varint,6,opt,name=aliveCount,proto3" json:"aliveCount,omitempty"`
+	Host         string `protobuf:"bytes,7,opt,name=host,proto3" json:"host,omitempty"`
+	MaxPlayers   int32  `protobuf:"varint,8,opt,name=maxPlayers,proto3" json:"maxPlayers,omitempty"`
+}
+
+func (x *SessionSummary) Reset() {
+	*x = SessionSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionSummary) ProtoMessage() {}
+
+func (x *SessionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionSummary.ProtoReflect.Descriptor instead.
+func (*SessionSummary) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SessionSummary) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetIsStarted() bool {
+	if x != nil {
+		return x.IsStarted
+	}
+	return false
+}
+
+func (x *SessionSummary) GetIsEnded() bool {
+	if x != nil {
+		return x.IsEnded
+	}
+	return false
+}
+
+func (x *SessionSummary) GetPhase() Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return Phase_UNKNOWN_PHASE
+}
+
+func (x *SessionSummary) GetPlayersCount() int32 {
+	if x != nil {
+		return x.PlayersCount
+	}
+	return 0
+}
+
+func (x *SessionSummary) GetAliveCount() int32 {
+	if x != nil {
+		return x.AliveCount
+	}
+	return 0
+}
+
+func (x *SessionSummary) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+type SessionList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*SessionSummary `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *SessionList) Reset() {
+	*x = SessionList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionList) ProtoMessage() {}
+
+func (x *SessionList) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionList.ProtoReflect.Descriptor instead.
+func (*SessionList) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SessionList) GetSessions() []*SessionSummary {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type AdminSessionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId       string                   `protobuf:"bytes,1,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	IsStarted       bool                     `protobuf:"varint,2,opt,name=isStarted,proto3" json:"isStarted,omitempty"`
+	IsEnded         bool                     `protobuf:"varint,3,opt,name=isEnded,proto3" json:"isEnded,omitempty"`
+	Phase           Phase                    `protobuf:"varint,4,opt,name=phase,proto3,enum=mafia.Phase" json:"phase,omitempty"`
+	Number          int32                    `protobuf:"varint,5,opt,name=number,proto3" json:"number,omitempty"`
+	SecondsLeft     int32                    `protobuf:"varint,6,opt,name=secondsLeft,proto3" json:"secondsLeft,omitempty"`
+	Paused          bool                     `protobuf:"varint,7,opt,name=paused,proto3" json:"paused,omitempty"`
+	Host            string                   `protobuf:"bytes,8,opt,name=host,proto3" json:"host,omitempty"`
+	HasModerator    bool                     `protobuf:"varint,9,opt,name=hasModerator,proto3" json:"hasModerator,omitempty"`
+	SpectatorsCount int32                    `protobuf:"varint,10,opt,name=spectatorsCount,proto3" json:"spectatorsCount,omitempty"`
+	WinnerTeam      Team                     `protobuf:"varint,11,opt,name=winnerTeam,proto3,enum=mafia.Team" json:"winnerTeam,omitempty"`
+	Players         []*Player                `protobuf:"bytes,12,rep,name=players,proto3" json:"players,omitempty"`
+	Votes           []*AdminSessionInfo_Vote `protobuf:"bytes,13,rep,name=votes,proto3" json:"votes,omitempty"`
+	IsChecked       bool                     `protobuf:"varint,14,opt,name=isChecked,proto3" json:"isChecked,omitempty"`
+}
+
+func (x *AdminSessionInfo) Reset() {
+	*x = AdminSessionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminSessionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSessionInfo) ProtoMessage() {}
+
+func (x *AdminSessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSessionInfo.ProtoReflect.Descriptor instead.
+func (*AdminSessionInfo) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AdminSessionInfo) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *AdminSessionInfo) GetIsStarted() bool {
+	if x != nil {
+		return x.IsStarted
+	}
+	return false
+}
+
+func (x *AdminSessionInfo) GetIsEnded() bool {
+	if x != nil {
+		return x.IsEnded
+	}
+	return false
+}
+
+func (x *AdminSessionInfo) GetPhase() Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return Phase_UNKNOWN_PHASE
+}
+
+func (x *AdminSessionInfo) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *AdminSessionInfo) GetSecondsLeft() int32 {
+	if x != nil {
+		return x.SecondsLeft
+	}
+	return 0
+}
+
+func (x *AdminSessionInfo) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *AdminSessionInfo) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *AdminSessionInfo) GetHasModerator() bool {
+	if x != nil {
+		return x.HasModerator
+	}
+	return false
+}
+
+func (x *AdminSessionInfo) GetSpectatorsCount() int32 {
+	if x != nil {
+		return x.SpectatorsCount
+	}
+	return 0
+}
+
+func (x *AdminSessionInfo) GetWinnerTeam() Team {
+	if x != nil {
+		return x.WinnerTeam
+	}
+	return Team_UNKNOWN_TEAM
+}
+
+func (x *AdminSessionInfo) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *AdminSessionInfo) GetVotes() []*AdminSessionInfo_Vote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+func (x *AdminSessionInfo) GetIsChecked() bool {
+	if x != nil {
+		return x.IsChecked
+	}
+	return false
+}
+
+type GameSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId       string `protobuf:"bytes,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	StartedAt    int64  `protobuf:"varint,2,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	FinishedAt   int64  `protobuf:"varint,3,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`
+	Winner       Team   `protobuf:"varint,4,opt,name=winner,proto3,enum=mafia.Team" json:"winner,omitempty"`
+	Role         Role   `protobuf:"varint,5,opt,name=role,proto3,enum=mafia.Role" json:"role,omitempty"`
+	Won          bool   `protobuf:"varint,6,opt,name=won,proto3" json:"won,omitempty"`
+	Survived     bool   `protobuf:"varint,7,opt,name=survived,proto3" json:"survived,omitempty"`
+	PlayersCount int32  `protobuf:"varint,8,opt,name=playersCount,proto3" json:"playersCount,omitempty"`
+}
+
+func (x *GameSummary) Reset() {
+	*x = GameSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameSummary) ProtoMessage() {}
+
+func (x *GameSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameSummary.ProtoReflect.Descriptor instead.
+func (*GameSummary) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GameSummary) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameSummary) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *GameSummary) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *GameSummary) GetWinner() Team {
+	if x != nil {
+		return x.Winner
+	}
+	return Team_UNKNOWN_TEAM
+}
+
+func (x *GameSummary) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_UNKNOWN_ROLE
+}
+
+func (x *GameSummary) GetWon() bool {
+	if x != nil {
+		return x.Won
+	}
+	return false
+}
+
+func (x *GameSummary) GetSurvived() bool {
+	if x != nil {
+		return x.Survived
+	}
+	return false
+}
+
+func (x *GameSummary) GetPlayersCount() int32 {
+	if x != nil {
+		return x.PlayersCount
+	}
+	return 0
+}
+
+type GameList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Games []*GameSummary `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+}
+
+func (x *GameList) Reset() {
+	*x = GameList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameList) ProtoMessage() {}
+
+func (x *GameList) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameList.ProtoReflect.Descriptor instead.
+func (*GameList) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GameList) GetGames() []*GameSummary {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type GameInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId     string                  `protobuf:"bytes,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	StartedAt  int64                   `protobuf:"varint,2,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	FinishedAt int64                   `protobuf:"varint,3,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`
+	Winner     Team                    `protobuf:"varint,4,opt,name=winner,proto3,enum=mafia.Team" json:"winner,omitempty"`
+	Players    []*Player               `protobuf:"bytes,5,rep,name=players,proto3" json:"players,omitempty"`
+	Phases     []*GameInfo_PhaseRecord `protobuf:"bytes,6,rep,name=phases,proto3" json:"phases,omitempty"`
+}
+
+func (x *GameInfo) Reset() {
+	*x = GameInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameInfo) ProtoMessage() {}
+
+func (x *GameInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameInfo.ProtoReflect.Descriptor instead.
+func (*GameInfo) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GameInfo) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameInfo) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *GameInfo) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *GameInfo) GetWinner() Team {
+	if x != nil {
+		return x.Winner
+	}
+	return Team_UNKNOWN_TEAM
+}
+
+func (x *GameInfo) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GameInfo) GetPhases() []*GameInfo_PhaseRecord {
+	if x != nil {
+		return x.Phases
+	}
+	return nil
+}
+
+type GameTimeline struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string                `protobuf:"bytes,1,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	Events []*GameTimeline_Event `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *GameTimeline) Reset() {
+	*x = GameTimeline{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameTimeline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameTimeline) ProtoMessage() {}
+
+func (x *GameTimeline) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameTimeline.ProtoReflect.Descriptor instead.
+func (*GameTimeline) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GameTimeline) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameTimeline) GetEvents() []*GameTimeline_Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type PlayerStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username      string                   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Games         int32                    `protobuf:"varint,2,opt,name=games,proto3" json:"games,omitempty"`
+	Wins          int32                    `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses        int32                    `protobuf:"varint,4,opt,name=losses,proto3" json:"losses,omitempty"`
+	Roles         []*PlayerStats_RoleStats `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles,omitempty"`
+	Teams         []*PlayerStats_TeamStats `protobuf:"bytes,6,rep,name=teams,proto3" json:"teams,omitempty"`
+	SurvivalRate  float64                  `protobuf:"fixed64,7,opt,name=survivalRate,proto3" json:"survivalRate,omitempty"`
+	Checks        int32                    `protobuf:"varint,8,opt,name=checks,proto3" json:"checks,omitempty"`
+	CheckAccuracy float64                  `protobuf:"fixed64,9,opt,name=checkAccuracy,proto3" json:"checkAccuracy,omitempty"`
+	MafiaVotes    int32                    `protobuf:"varint,10,opt,name=mafiaVotes,proto3" json:"mafiaVotes,omitempty"`
+	KillSuccess   float64                  `protobuf:"fixed64,11,opt,name=killSuccess,proto3" json:"killSuccess,omitempty"`
+	Rating        float64                  `protobuf:"fixed64,12,opt,name=rating,proto3" json:"rating,omitempty"`
+}
+
+func (x *PlayerStats) Reset() {
+	*x = PlayerStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStats) ProtoMessage() {}
+
+func (x *PlayerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStats.ProtoReflect.Descriptor instead.
+func (*PlayerStats) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PlayerStats) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *PlayerStats) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetRoles() []*PlayerStats_RoleStats {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetTeams() []*PlayerStats_TeamStats {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *PlayerStats) GetSurvivalRate() float64 {
+	if x != nil {
+		return x.SurvivalRate
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetChecks() int32 {
+	if x != nil {
+		return x.Checks
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetCheckAccuracy() float64 {
+	if x != nil {
+		return x.CheckAccuracy
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetMafiaVotes() int32 {
+	if x != nil {
+		return x.MafiaVotes
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetKillSuccess() float64 {
+	if x != nil {
+		return x.KillSuccess
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+type Leaderboard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*Leaderboard_Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *Leaderboard) Reset() {
+	*x = Leaderboard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Leaderboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Leaderboard) ProtoMessage() {}
+
+func (x *Leaderboard) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Leaderboard.ProtoReflect.Descriptor instead.
+func (*Leaderboard) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Leaderboard) GetEntries() []*Leaderboard_Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type WebSocketAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	// Types that are assignable to Action:
+	//	*WebSocketAction_Vote
+	//	*WebSocketAction_Check
+	//	*WebSocketAction_Ready
+	//	*WebSocketAction_StartGame
+	//	*WebSocketAction_Message
+	//	*WebSocketAction_GetState
+	Action isWebSocketAction_Action `protobuf_oneof:"action"`
+}
+
+func (x *WebSocketAction) Reset() {
+	*x = WebSocketAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebSocketAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebSocketAction) ProtoMessage() {}
+
+func (x *WebSocketAction) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebSocketAction.ProtoReflect.Descriptor instead.
+func (*WebSocketAction) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *WebSocketAction) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (m *WebSocketAction) GetAction() isWebSocketAction_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (x *WebSocketAction) GetVote() *VoteRequest {
+	if x, ok := x.GetAction().(*WebSocketAction_Vote); ok {
+		return x.Vote
+	}
+	return nil
+}
+
+func (x *WebSocketAction) GetCheck() *CheckRequest {
+	if x, ok := x.GetAction().(*WebSocketAction_Check); ok {
+		return x.Check
+	}
+	return nil
+}
+
+func (x *WebSocketAction) GetReady() *ReadyRequest {
+	if x, ok := x.GetAction().(*WebSocketAction_Ready); ok {
+		return x.Ready
+	}
+	return nil
+}
+
+func (x *WebSocketAction) GetStartGame() *Empty {
+	if x, ok := x.GetAction().(*WebSocketAction_StartGame); ok {
+		return x.StartGame
+	}
+	return nil
+}
+
+func (x *WebSocketAction) GetMessage() *ChatRequest {
+	if x, ok := x.GetAction().(*WebSocketAction_Message); ok {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *WebSocketAction) GetGetState() *Empty {
+	if x, ok := x.GetAction().(*WebSocketAction_GetState); ok {
+		return x.GetState
+	}
+	return nil
+}
+
+type isWebSocketAction_Action interface {
+	isWebSocketAction_Action()
+}
+
+type WebSocketAction_Vote struct {
+	Vote *VoteRequest `protobuf:"bytes,2,opt,name=vote,proto3,oneof"`
+}
+
+type WebSocketAction_Check struct {
+	Check *CheckRequest `protobuf:"bytes,3,opt,name=check,proto3,oneof"`
+}
+
+type WebSocketAction_Ready struct {
+	Ready *ReadyRequest `protobuf:"bytes,4,opt,name=ready,proto3,oneof"`
+}
+
+type WebSocketAction_StartGame struct {
+	StartGame *Empty `protobuf:"bytes,5,opt,name=startGame,proto3,oneof"`
+}
+
+type WebSocketAction_Message struct {
+	Message *ChatRequest `protobuf:"bytes,6,opt,name=message,proto3,oneof"`
+}
+
+type WebSocketAction_GetState struct {
+	GetState *Empty `protobuf:"bytes,7,opt,name=getState,proto3,oneof"`
+}
+
+func (*WebSocketAction_Vote) isWebSocketAction_Action() {}
+
+func (*WebSocketAction_Check) isWebSocketAction_Action() {}
+
+func (*WebSocketAction_Ready) isWebSocketAction_Action() {}
+
+func (*WebSocketAction_StartGame) isWebSocketAction_Action() {}
+
+func (*WebSocketAction_Message) isWebSocketAction_Action() {}
+
+func (*WebSocketAction_GetState) isWebSocketAction_Action() {}
+
+type WebSocketFrame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	// Types that are assignable to Frame:
+	//	*WebSocketFrame_Event
+	//	*WebSocketFrame_Token
+	//	*WebSocketFrame_Ok
+	//	*WebSocketFrame_State
+	//	*WebSocketFrame_CheckResult
+	//	*WebSocketFrame_Error_
+	Frame isWebSocketFrame_Frame `protobuf_oneof:"frame"`
+}
+
+func (x *WebSocketFrame) Reset() {
+	*x = WebSocketFrame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebSocketFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebSocketFrame) ProtoMessage() {}
+
+func (x *WebSocketFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebSocketFrame.ProtoReflect.Descriptor instead.
+func (*WebSocketFrame) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WebSocketFrame) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (m *WebSocketFrame) GetFrame() isWebSocketFrame_Frame {
+	if m != nil {
+		return m.Frame
+	}
+	return nil
+}
+
+func (x *WebSocketFrame) GetEvent() *SessionEvent {
+	if x, ok := x.GetFrame().(*WebSocketFrame_Event); ok {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *WebSocketFrame) GetToken() string {
+	if x, ok := x.GetFrame().(*WebSocketFrame_Token); ok {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *WebSocketFrame) GetOk() *Empty {
+	if x, ok := x.GetFrame().(*WebSocketFrame_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *WebSocketFrame) GetState() *SessionState {
+	if x, ok := x.GetFrame().(*WebSocketFrame_State); ok {
+		return x.State
+	}
+	return nil
+}
+
+func (x *WebSocketFrame) GetCheckResult() *CheckResponse {
+	if x, ok := x.GetFrame().(*WebSocketFrame_CheckResult); ok {
+		return x.CheckResult
+	}
+	return nil
+}
+
+func (x *WebSocketFrame) GetError() *WebSocketFrame_Error {
+	if x, ok := x.GetFrame().(*WebSocketFrame_Error_); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isWebSocketFrame_Frame interface {
+	isWebSocketFrame_Frame()
+}
+
+type WebSocketFrame_Event struct {
+	Event *SessionEvent `protobuf:"bytes,2,opt,name=event,proto3,oneof"`
+}
+
+type WebSocketFrame_Token struct {
+	Token string `protobuf:"bytes,3,opt,name=token,proto3,oneof"`
+}
+
+type WebSocketFrame_Ok struct {
+	Ok *Empty `protobuf:"bytes,4,opt,name=ok,proto3,oneof"`
+}
+
+type WebSocketFrame_State struct {
+	State *SessionState `protobuf:"bytes,5,opt,name=state,proto3,oneof"`
+}
+
+type WebSocketFrame_CheckResult struct {
+	CheckResult *CheckResponse `protobuf:"bytes,6,opt,name=checkResult,proto3,oneof"`
+}
+
+type WebSocketFrame_Error_ struct {
+	Error *WebSocketFrame_Error `protobuf:"bytes,7,opt,name=error,proto3,oneof"`
+}
+
+func (*WebSocketFrame_Event) isWebSocketFrame_Frame() {}
+
+func (*WebSocketFrame_Token) isWebSocketFrame_Frame() {}
+
+func (*WebSocketFrame_Ok) isWebSocketFrame_Frame() {}
+
+func (*WebSocketFrame_State) isWebSocketFrame_Frame() {}
+
+func (*WebSocketFrame_CheckResult) isWebSocketFrame_Frame() {}
+
+func (*WebSocketFrame_Error_) isWebSocketFrame_Frame() {}
+
+type SessionEvent_SessionStartInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role      Role      `protobuf:"varint,1,opt,name=role,proto3,enum=mafia.Role" json:"role,omitempty"`
+	Players   []*Player `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+	SessionId string    `protobuf:"bytes,3,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+}
+
+func (x *SessionEvent_SessionStartInfo) Reset() {
+	*x = SessionEvent_SessionStartInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionEvent_SessionStartInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEvent_SessionStartInfo) ProtoMessage() {}
+
+func (x *SessionEvent_SessionStartInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEvent_SessionStartInfo.ProtoReflect.Descriptor instead.
+func (*SessionEvent_SessionStartInfo) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *SessionEvent_SessionStartInfo) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_UNKNOWN_ROLE
+}
+
+func (x *SessionEvent_SessionStartInfo) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *SessionEvent_SessionStartInfo) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type SessionEvent_SessionFinishInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Winners Team      `protobuf:"varint,1,opt,name=winners,proto3,enum=mafia.Team" json:"winners,omitempty"`
+	Players []*Player `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+}
+
+func (x *SessionEvent_SessionFinishInfo) Reset() {
+	*x = SessionEvent_SessionFinishInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionEvent_SessionFinishInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEvent_SessionFinishInfo) ProtoMessage() {}
+
+func (x *SessionEvent_SessionFinishInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEvent_SessionFinishInfo.ProtoReflect.Descriptor instead.
+func (*SessionEvent_SessionFinishInfo) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{19, 1}
+}
+
+func (x *SessionEvent_SessionFinishInfo) GetWinners() Team {
+	if x != nil {
+		return x.Winners
+	}
+	return Team_UNKNOWN_TEAM
+}
+
+func (x *SessionEvent_SessionFinishInfo) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type SessionEvent_PlayerJoinInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *SessionEvent_PlayerJoinInfo) Reset() {
+	*x = SessionEvent_PlayerJoinInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionEvent_PlayerJoinInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEvent_PlayerJoinInfo) ProtoMessage() {}
+
+func (x *SessionEvent_PlayerJoinInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEvent_PlayerJoinInfo.ProtoReflect.Descriptor instead.
+func (*SessionEvent_PlayerJoinInfo) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{19, 2}
+}
+
+func (x *SessionEvent_PlayerJoinInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type SessionEvent_PlayerLeftInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *SessionEvent_PlayerLeftInfo) Reset() {
+	*x = SessionEvent_PlayerLeftInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionEvent_PlayerLeftInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEvent_PlayerLeftInfo) ProtoMessage() {}
+
+func (x *SessionEvent_PlayerLeftInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEvent_PlayerLeftInfo.ProtoReflect.Descriptor instead.
+func (*SessionEvent_PlayerLeftInfo) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{19, 3}
+}
+
+func (x *SessionEvent_PlayerLeftInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type SessionEvent_VoteInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *SessionEvent_VoteInfo) Reset() {
+	*x = SessionEvent_VoteInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionEvent_VoteInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEvent_VoteInfo) ProtoMessage() {}
+
+func (x *SessionEvent_VoteInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEvent_VoteInfo.ProtoReflect.Descriptor instead.
+func (*SessionEvent_VoteInfo) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{19, 4}
+}
+
+func (x *SessionEvent_VoteInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type SessionEvent_LobbyPlayer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Ready    bool   `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
+}
+
+func (x *SessionEvent_LobbyPlayer) Reset() {
+	*x = SessionEvent_LobbyPlayer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionEvent_LobbyPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEvent_LobbyPlayer) ProtoMessage() {}
+
+func (x *SessionEvent_LobbyPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEvent_LobbyPlayer.ProtoReflect.Descriptor instead.
+func (*SessionEvent_LobbyPlayer) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{19, 5}
+}
+
+func (x *SessionEvent_LobbyPlayer) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SessionEvent_LobbyPlayer) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+type SessionEvent_LobbyUpdateInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host       string                      `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Players    []*SessionEvent_LobbyPlayer `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+	MinPlayers int32                       `protobuf:"varint,3,opt,name=minPlayers,proto3" json:"minPlayers,omitempty"`
+	MaxPlayers int32                       `protobuf:"varint,4,opt,name=maxPlayers,proto3" json:"maxPlayers,omitempty"`
+	SessionId  string                      `protobuf:"bytes,5,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+}
+
+func (x *SessionEvent_LobbyUpdateInfo) Reset() {
+	*x = SessionEvent_LobbyUpdateInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionEvent_LobbyUpdateInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEvent_LobbyUpdateInfo) ProtoMessage() {}
+
+func (x *SessionEvent_LobbyUpdateInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEvent_LobbyUpdateInfo.ProtoReflect.Descriptor instead.
+func (*SessionEvent_LobbyUpdateInfo) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{19, 6}
+}
+
+func (x *SessionEvent_LobbyUpdateInfo) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *SessionEvent_LobbyUpdateInfo) GetPlayers() []*SessionEvent_LobbyPlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *SessionEvent_LobbyUpdateInfo) GetMinPlayers() int32 {
+	if x != nil {
+		return x.MinPlayers
+	}
+	return 0
+}
+
+func (x *SessionEvent_LobbyUpdateInfo) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *SessionEvent_LobbyUpdateInfo) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type SessionEvent_PhaseInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phase       Phase `protobuf:"varint,1,opt,name=phase,proto3,enum=mafia.Phase" json:"phase,omitempty"`
+	Number      int32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	SecondsLeft int32 `protobuf:"varint,3,opt,name=secondsLeft,proto3" json:"secondsLeft,omitempty"`
+	Paused      bool  `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (x *SessionEvent_PhaseInfo) Reset() {
+	*x = SessionEvent_PhaseInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionEvent_PhaseInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEvent_PhaseInfo) ProtoMessage() {}
+
+func (x *SessionEvent_PhaseInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEvent_PhaseInfo.ProtoReflect.Descriptor instead.
+func (*SessionEvent_PhaseInfo) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{19, 7}
+}
+
+func (x *SessionEvent_PhaseInfo) GetPhase() Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return Phase_UNKNOWN_PHASE
+}
+
+func (x *SessionEvent_PhaseInfo) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *SessionEvent_PhaseInfo) GetSecondsLeft() int32 {
+	if x != nil {
+		return x.SecondsLeft
+	}
+	return 0
+}
+
+func (x *SessionEvent_PhaseInfo) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type SessionEvent_ModeratorActionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action   ModeratorAction `protobuf:"varint,1,opt,name=action,proto3,enum=mafia.ModeratorAction" json:"action,omitempty"`
+	Username string          `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *SessionEvent_ModeratorActionInfo) Reset() {
+	*x = SessionEvent_ModeratorActionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionEvent_ModeratorActionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEvent_ModeratorActionInfo) ProtoMessage() {}
+
+func (x *SessionEvent_ModeratorActionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEvent_ModeratorActionInfo.ProtoReflect.Descriptor instead.
+func (*SessionEvent_ModeratorActionInfo) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{19, 8}
+}
+
+func (x *SessionEvent_ModeratorActionInfo) GetAction() ModeratorAction {
+	if x != nil {
+		return x.Action
+	}
+	return ModeratorAction_UNKNOWN_MODERATOR_ACTION
+}
+
+func (x *SessionEvent_ModeratorActionInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type SessionEvent_ChatInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string      `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Text     string      `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Channel  ChatChannel `protobuf:"varint,3,opt,name=channel,proto3,enum=mafia.ChatChannel" json:"channel,omitempty"`
+}
+
+func (x *SessionEvent_ChatInfo) Reset() {
+	*x = SessionEvent_ChatInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionEvent_ChatInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEvent_ChatInfo) ProtoMessage() {}
+
+func (x *SessionEvent_ChatInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEvent_ChatInfo.ProtoReflect.Descriptor instead.
+func (*SessionEvent_ChatInfo) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{19, 9}
+}
+
+func (x *SessionEvent_ChatInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SessionEvent_ChatInfo) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SessionEvent_ChatInfo) GetChannel() ChatChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return ChatChannel_PUBLIC_CHANNEL
+}
+
+type SessionEvent_NightActionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action     NightAction `protobuf:"varint,1,opt,name=action,proto3,enum=mafia.NightAction" json:"action,omitempty"`
+	Username   string      `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Target     string      `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	TargetRole Role        `protobuf:"varint,4,opt,name=targetRole,proto3,enum=mafia.Role" json:"targetRole,omitempty"`
+}
+
+func (x *SessionEvent_NightActionInfo) Reset() {
+	*x = SessionEvent_NightActionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionEvent_NightActionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEvent_NightActionInfo) ProtoMessage() {}
+
+func (x *SessionEvent_NightActionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEvent_NightActionInfo.ProtoReflect.Descriptor instead.
+func (*SessionEvent_NightActionInfo) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{19, 10}
+}
+
+func (x *SessionEvent_NightActionInfo) GetAction() NightAction {
+	if x != nil {
+		return x.Action
+	}
+	return NightAction_UNKNOWN_ACTION
+}
+
+func (x *SessionEvent_NightActionInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SessionEvent_NightActionInfo) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *SessionEvent_NightActionInfo) GetTargetRole() Role {
+	if x != nil {
+		return x.TargetRole
+	}
+	return Role_UNKNOWN_ROLE
+}
+
+type SessionEvent_ServerShutdownInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SecondsLeft int32 `protobuf:"varint,1,opt,name=secondsLeft,proto3" json:"secondsLeft,omitempty"`
+	Resumable   bool  `protobuf:"varint,2,opt,name=resumable,proto3" json:"resumable,omitempty"`
+}
+
+func (x *SessionEvent_ServerShutdownInfo) Reset() {
+	*x = SessionEvent_ServerShutdownInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionEvent_ServerShutdownInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEvent_ServerShutdownInfo) ProtoMessage() {}
+
+func (x *SessionEvent_ServerShutdownInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEvent_ServerShutdownInfo.ProtoReflect.Descriptor instead.
+func (*SessionEvent_ServerShutdownInfo) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{19, 11}
+}
+
+func (x *SessionEvent_ServerShutdownInfo) GetSecondsLeft() int32 {
+	if x != nil {
+		return x.SecondsLeft
+	}
+	return 0
+}
+
+func (x *SessionEvent_ServerShutdownInfo) GetResumable() bool {
+	if x != nil {
+		return x.Resumable
+	}
+	return false
+}
+
+type AdminSessionInfo_Vote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Voted    string `protobuf:"bytes,2,opt,name=voted,proto3" json:"voted,omitempty"`
+}
+
+func (x *AdminSessionInfo_Vote) Reset() {
+	*x = AdminSessionInfo_Vote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminSessionInfo_Vote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSessionInfo_Vote) ProtoMessage() {}
+
+func (x *AdminSessionInfo_Vote) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSessionInfo_Vote.ProtoReflect.Descriptor instead.
+func (*AdminSessionInfo_Vote) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *AdminSessionInfo_Vote) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AdminSessionInfo_Vote) GetVoted() string {
+	if x != nil {
+		return x.Voted
+	}
+	return ""
+}
+
+type GameInfo_Vote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Voted    string `protobuf:"bytes,2,opt,name=voted,proto3" json:"voted,omitempty"`
+}
+
+func (x *GameInfo_Vote) Reset() {
+	*x = GameInfo_Vote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameInfo_Vote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameInfo_Vote) ProtoMessage() {}
+
+func (x *GameInfo_Vote) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameInfo_Vote.ProtoReflect.Descriptor instead.
+func (*GameInfo_Vote) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{28, 0}
+}
+
+func (x *GameInfo_Vote) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GameInfo_Vote) GetVoted() string {
+	if x != nil {
+		return x.Voted
+	}
+	return ""
+}
+
+type GameInfo_Check struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username   string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Target     string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	TargetRole Role   `protobuf:"varint,3,opt,name=targetRole,proto3,enum=mafia.Role" json:"targetRole,omitempty"`
+}
+
+func (x *GameInfo_Check) Reset() {
+	*x = GameInfo_Check{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameInfo_Check) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameInfo_Check) ProtoMessage() {}
+
+func (x *GameInfo_Check) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameInfo_Check.ProtoReflect.Descriptor instead.
+func (*GameInfo_Check) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{28, 1}
+}
+
+func (x *GameInfo_Check) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GameInfo_Check) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *GameInfo_Check) GetTargetRole() Role {
+	if x != nil {
+		return x.TargetRole
+	}
+	return Role_UNKNOWN_ROLE
+}
+
+type GameInfo_PhaseRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phase      Phase             `protobuf:"varint,1,opt,name=phase,proto3,enum=mafia.Phase" json:"phase,omitempty"`
+	Number     int32             `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Votes      []*GameInfo_Vote  `protobuf:"bytes,3,rep,name=votes,proto3" json:"votes,omitempty"`
+	Eliminated string            `protobuf:"bytes,4,opt,name=eliminated,proto3" json:"eliminated,omitempty"`
+	Checks     []*GameInfo_Check `protobuf:"bytes,5,rep,name=checks,proto3" json:"checks,omitempty"`
+	Removed    []string          `protobuf:"bytes,6,rep,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (x *GameInfo_PhaseRecord) Reset() {
+	*x = GameInfo_PhaseRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameInfo_PhaseRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameInfo_PhaseRecord) ProtoMessage() {}
+
+func (x *GameInfo_PhaseRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameInfo_PhaseRecord.ProtoReflect.Descriptor instead.
+func (*GameInfo_PhaseRecord) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{28, 2}
+}
+
+func (x *GameInfo_PhaseRecord) GetPhase() Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return Phase_UNKNOWN_PHASE
+}
+
+func (x *GameInfo_PhaseRecord) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *GameInfo_PhaseRecord) GetVotes() []*GameInfo_Vote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+func (x *GameInfo_PhaseRecord) GetEliminated() string {
+	if x != nil {
+		return x.Eliminated
+	}
+	return ""
+}
+
+func (x *GameInfo_PhaseRecord) GetChecks() []*GameInfo_Check {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *GameInfo_PhaseRecord) GetRemoved() []string {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+type GameTimeline_Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OffsetMs int64         `protobuf:"varint,1,opt,name=offsetMs,proto3" json:"offsetMs,omitempty"`
+	Event    *SessionEvent `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *GameTimeline_Event) Reset() {
+	*x = GameTimeline_Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameTimeline_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameTimeline_Event) ProtoMessage() {}
+
+func (x *GameTimeline_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameTimeline_Event.ProtoReflect.Descriptor instead.
+func (*GameTimeline_Event) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *GameTimeline_Event) GetOffsetMs() int64 {
+	if x != nil {
+		return x.OffsetMs
+	}
+	return 0
+}
+
+func (x *GameTimeline_Event) GetEvent() *SessionEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type PlayerStats_RoleStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role  Role  `protobuf:"varint,1,opt,name=role,proto3,enum=mafia.Role" json:"role,omitempty"`
+	Games int32 `protobuf:"varint,2,opt,name=games,proto3" json:"games,omitempty"`
+	Wins  int32 `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+}
+
+func (x *PlayerStats_RoleStats) Reset() {
+	*x = PlayerStats_RoleStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerStats_RoleStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStats_RoleStats) ProtoMessage() {}
+
+func (x *PlayerStats_RoleStats) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStats_RoleStats.ProtoReflect.Descriptor instead.
+func (*PlayerStats_RoleStats) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{30, 0}
+}
+
+func (x *PlayerStats_RoleStats) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_UNKNOWN_ROLE
+}
+
+func (x *PlayerStats_RoleStats) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *PlayerStats_RoleStats) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+type PlayerStats_TeamStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Team  Team  `protobuf:"varint,1,opt,name=team,proto3,enum=mafia.Team" json:"team,omitempty"`
+	Games int32 `protobuf:"varint,2,opt,name=games,proto3" json:"games,omitempty"`
+	Wins  int32 `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+}
+
+func (x *PlayerStats_TeamStats) Reset() {
+	*x = PlayerStats_TeamStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerStats_TeamStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStats_TeamStats) ProtoMessage() {}
+
+func (x *PlayerStats_TeamStats) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStats_TeamStats.ProtoReflect.Descriptor instead.
+func (*PlayerStats_TeamStats) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{30, 1}
+}
+
+func (x *PlayerStats_TeamStats) GetTeam() Team {
+	if x != nil {
+		return x.Team
+	}
+	return Team_UNKNOWN_TEAM
+}
+
+func (x *PlayerStats_TeamStats) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *PlayerStats_TeamStats) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+type Leaderboard_Entry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string  `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Games    int32   `protobuf:"varint,2,opt,name=games,proto3" json:"games,omitempty"`
+	Wins     int32   `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	WinRate  float64 `protobuf:"fixed64,4,opt,name=winRate,proto3" json:"winRate,omitempty"`
+	Rating   float64 `protobuf:"fixed64,5,opt,name=rating,proto3" json:"rating,omitempty"`
+}
+
+func (x *Leaderboard_Entry) Reset() {
+	*x = Leaderboard_Entry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Leaderboard_Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Leaderboard_Entry) ProtoMessage() {}
+
+func (x *Leaderboard_Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Leaderboard_Entry.ProtoReflect.Descriptor instead.
+func (*Leaderboard_Entry) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *Leaderboard_Entry) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Leaderboard_Entry) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *Leaderboard_Entry) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *Leaderboard_Entry) GetWinRate() float64 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+func (x *Leaderboard_Entry) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+type WebSocketFrame_Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *WebSocketFrame_Error) Reset() {
+	*x = WebSocketFrame_Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mafia_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebSocketFrame_Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebSocketFrame_Error) ProtoMessage() {}
+
+func (x *WebSocketFrame_Error) ProtoReflect() protoreflect.Message {
+	mi := &file_mafia_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebSocketFrame_Error.ProtoReflect.Descriptor instead.
+func (*WebSocketFrame_Error) Descriptor() ([]byte, []int) {
+	return file_mafia_proto_rawDescGZIP(), []int{33, 0}
+}
+
+func (x *WebSocketFrame_Error) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *WebSocketFrame_Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_mafia_proto protoreflect.FileDescriptor
+
+var file_mafia_proto_rawDesc = []byte{
 	0x0a, 0x0b, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x6d,
-	0x61, 0x66, 0x69, 0x61, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x31, 0x0a,
-	0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x29, 0x0a, 0x0b, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x61, 0x66, 0x69, 0x61, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0a,
+	0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2a, 0x0a, 0x0c, 0x53,
-	0x68, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2a, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x45, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x1b,
+	0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x2d, 0x0a, 0x0d, 0x52,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x25, 0x0a, 0x0b, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x61, 0x6d,
+	0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x22, 0x30, 0x0a, 0x12, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1f, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b,
-	0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c,
-	0x65, 0x22, 0x61, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x04, 0x72,
-	0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x6d, 0x61, 0x66, 0x69,
-	0x61, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x76, 0x65,
-	0x6e, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x69, 0x76, 0x65,
-	0x6e, 0x65, 0x73, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x07,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
-	0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x54,
-	0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x6d, 0x61, 0x66, 0x69,
-	0x61, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x65,
-	0x61, 0x6d, 0x22, 0xda, 0x05, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x09,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x47, 0x0a, 0x0a, 0x66, 0x69, 0x6e,
-	0x69, 0x73, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x61, 0x6d, 0x65, 0x22, 0x2a, 0x0a, 0x12, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0x29, 0x0a, 0x0b, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2a, 0x0a, 0x0c, 0x53, 0x68,
+	0x6f, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x24, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x22, 0x21, 0x0a, 0x0b,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22,
+	0x2f, 0x0a, 0x0f, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x22, 0x2f, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0x2e, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x2a, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a,
+	0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
+	0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x61, 0x0a, 0x06, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x22, 0xcd,
+	0x01, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x25, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12,
+	0x2b, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x54, 0x65, 0x61, 0x6d,
+	0x52, 0x0a, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x05, 0x70, 0x68,
+	0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69,
+	0x61, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x22, 0xd9,
+	0x0f, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x44, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x47, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x61, 0x66, 0x69,
+	0x61, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x49, 0x6e, 0x66, 0x6f,
+	0x48, 0x00, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x40,
+	0x0a, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x40, 0x0a, 0x08, 0x6c, 0x65, 0x66, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x65,
+	0x66, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x08, 0x6c, 0x65, 0x66, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x3a, 0x0a, 0x08, 0x76, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x48, 0x00, 0x52, 0x08, 0x76, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x47,
+	0x0a, 0x0b, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0b, 0x6c, 0x6f, 0x62, 0x62,
+	0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x70, 0x68, 0x61, 0x73, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x66,
+	0x69, 0x61, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e,
+	0x50, 0x68, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x09, 0x70, 0x68, 0x61,
+	0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
+	0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68,
+	0x61, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x4f, 0x0a, 0x0f, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x61,
+	0x66, 0x69, 0x61, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x4e, 0x69, 0x67, 0x68, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x48, 0x00, 0x52, 0x0f, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x5b, 0x0a, 0x13, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x13, 0x6d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x4c, 0x0a, 0x0c, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00,
+	0x52, 0x0c, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x7a,
+	0x0a, 0x10, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0b, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x1a, 0x63, 0x0a, 0x11, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x25, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0b, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x07, 0x77,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x1a,
+	0x2c, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x2c, 0x0a,
+	0x0e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x26, 0x0a, 0x08, 0x56,
+	0x6f, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x1a, 0x3f, 0x0a, 0x0b, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72,
+	0x65, 0x61, 0x64, 0x79, 0x1a, 0xbe, 0x01, 0x0a, 0x0f, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x07,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
 	0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
-	0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x40, 0x0a, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x4a, 0x6f, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x40, 0x0a, 0x08, 0x6c, 0x65, 0x66, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x08, 0x6c, 0x65,
-	0x66, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a, 0x0a, 0x08, 0x76, 0x6f, 0x74, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
-	0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x6f,
-	0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x08, 0x76, 0x6f, 0x74, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x1a, 0x5c, 0x0a, 0x10, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x52, 0x6f, 0x6c,
-	0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
+	0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69, 0x6e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x1a, 0x81, 0x01, 0x0a, 0x09, 0x50, 0x68, 0x61, 0x73, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x22, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65,
+	0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x20, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x4c, 0x65, 0x66,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x1a, 0x61, 0x0a, 0x13, 0x4d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x2e, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x68, 0x0a, 0x08,
+	0x43, 0x68, 0x61, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x66, 0x69,
+	0x61, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x1a, 0x9e, 0x01, 0x0a, 0x0f, 0x4e, 0x69, 0x67, 0x68, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x66,
+	0x69, 0x61, 0x2e, 0x4e, 0x69, 0x67, 0x68, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2b, 0x0a, 0x0a, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b,
+	0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x0a, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x1a, 0x54, 0x0a, 0x12, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a,
+	0x0b, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x0b, 0x0a,
+	0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x33, 0x0a, 0x13, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22,
+	0x4c, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4b, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x51, 0x0a,
+	0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x82, 0x02, 0x0a, 0x0e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x69, 0x73, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x69, 0x73, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x05, 0x70, 0x68, 0x61,
+	0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
+	0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x40, 0x0a, 0x0b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x08, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa2, 0x04, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69,
+	0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x45, 0x6e,
+	0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x45, 0x6e, 0x64,
+	0x65, 0x64, 0x12, 0x22, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52,
+	0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20,
+	0x0a, 0x0b, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x4c, 0x65, 0x66, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c,
+	0x68, 0x61, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x28, 0x0a, 0x0f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x0a, 0x77, 0x69,
+	0x6e, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b,
+	0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x0a, 0x77, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x27, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
 	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
-	0x1a, 0x63, 0x0a, 0x11, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x73,
-	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x54,
-	0x65, 0x61, 0x6d, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x07,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
-	0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x73, 0x1a, 0x2c, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4a,
-	0x6f, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x12, 0x32, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x64, 0x1a, 0x38, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x22, 0xfb, 0x01, 0x0a,
+	0x0b, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52,
+	0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x77, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x77, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75,
+	0x72, 0x76, 0x69, 0x76, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x75,
+	0x72, 0x76, 0x69, 0x76, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x34, 0x0a, 0x08, 0x47, 0x61,
+	0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73,
+	0x22, 0xe8, 0x04, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x54, 0x65, 0x61, 0x6d,
+	0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x66, 0x69,
+	0x61, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x12, 0x33, 0x0a, 0x06, 0x70, 0x68, 0x61, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06,
+	0x70, 0x68, 0x61, 0x73, 0x65, 0x73, 0x1a, 0x38, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f,
+	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x64,
+	0x1a, 0x68, 0x0a, 0x05, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2b, 0x0a,
+	0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0b, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x0a,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x1a, 0xde, 0x01, 0x0a, 0x0b, 0x50,
+	0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x22, 0x0a, 0x05, 0x70, 0x68,
+	0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69,
+	0x61, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74,
+	0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0xa9, 0x01, 0x0a, 0x0c,
+	0x47, 0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x4e, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4d, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4d, 0x73, 0x12, 0x29, 0x0a, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61,
+	0x66, 0x69, 0x61, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xbf, 0x04, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x1a, 0x2c, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x65, 0x66,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x1a, 0x26, 0x0a, 0x08, 0x56, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x27, 0x0a, 0x09, 0x53, 0x68, 0x6f,
-	0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c,
+	0x6f, 0x73, 0x73, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x74, 0x65, 0x61,
+	0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x61,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x0a,
+	0x0c, 0x73, 0x75, 0x72, 0x76, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0c, 0x73, 0x75, 0x72, 0x76, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x1a, 0x56, 0x0a, 0x09, 0x52, 0x6f, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e,
+	0x73, 0x1a, 0x56, 0x0a, 0x09, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1f,
+	0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x6d,
+	0x61, 0x66, 0x69, 0x61, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12,
+	0x14, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x67, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x22, 0xc2, 0x01, 0x0a, 0x0b, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x66,
+	0x69, 0x61, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x7f, 0x0a,
+	0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
 	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2a,
-	0x43, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x41, 0x46,
-	0x49, 0x41, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x49, 0x56,
-	0x49, 0x4c, 0x49, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x48, 0x45, 0x52, 0x49,
-	0x46, 0x46, 0x10, 0x03, 0x2a, 0x32, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x10, 0x0a, 0x0c,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54, 0x45, 0x41, 0x4d, 0x10, 0x00, 0x12, 0x09,
-	0x0a, 0x05, 0x4d, 0x41, 0x46, 0x49, 0x41, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x49, 0x56,
-	0x49, 0x4c, 0x49, 0x41, 0x4e, 0x53, 0x10, 0x02, 0x32, 0xde, 0x01, 0x0a, 0x05, 0x4d, 0x61, 0x66,
-	0x69, 0x61, 0x12, 0x41, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
-	0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x28, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x2e,
-	0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x77, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x77,
+	0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0xc7,
+	0x02, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x28, 0x0a, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x66, 0x69,
+	0x61, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x2b, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x05, 0x72,
+	0x65, 0x61, 0x64, 0x79, 0x12, 0x2c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61,
+	0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x48, 0x00, 0x52, 0x08, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x08,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xef, 0x02, 0x0a, 0x0e, 0x57, 0x65, 0x62,
+	0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
+	0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e,
+	0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x61, 0x66,
+	0x69, 0x61, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x2b,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x57, 0x65, 0x62,
+	0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x35, 0x0a, 0x05, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x42, 0x07, 0x0a, 0x05, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x2a, 0x43, 0x0a, 0x04, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x52, 0x4f,
+	0x4c, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x41, 0x46, 0x49, 0x41, 0x5f, 0x52, 0x4f,
+	0x4c, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x49, 0x56, 0x49, 0x4c, 0x49, 0x41, 0x4e,
+	0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x48, 0x45, 0x52, 0x49, 0x46, 0x46, 0x10, 0x03, 0x2a,
+	0x2e, 0x0a, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x44,
+	0x41, 0x59, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x49, 0x47, 0x48, 0x54, 0x10, 0x02, 0x2a,
+	0x33, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12,
+	0x0a, 0x0e, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c,
+	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x45, 0x41, 0x44, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e,
+	0x45, 0x4c, 0x10, 0x01, 0x2a, 0x44, 0x0a, 0x0b, 0x4e, 0x69, 0x67, 0x68, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x41, 0x46, 0x49, 0x41,
+	0x5f, 0x56, 0x4f, 0x54, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x48, 0x45, 0x52, 0x49,
+	0x46, 0x46, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x10, 0x02, 0x2a, 0x9a, 0x01, 0x0a, 0x0f, 0x4d,
+	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x18, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41,
+	0x54, 0x4f, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
+	0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x52, 0x10, 0x01, 0x12, 0x10, 0x0a,
+	0x0c, 0x52, 0x45, 0x53, 0x55, 0x4d, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x52, 0x10, 0x02, 0x12,
+	0x11, 0x0a, 0x0d, 0x41, 0x44, 0x56, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45,
+	0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x4b, 0x49, 0x4c, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45,
+	0x52, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x56, 0x49, 0x56, 0x45, 0x5f, 0x50, 0x4c,
+	0x41, 0x59, 0x45, 0x52, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x4b, 0x49, 0x43, 0x4b, 0x5f, 0x50,
+	0x4c, 0x41, 0x59, 0x45, 0x52, 0x10, 0x06, 0x2a, 0x32, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x12,
+	0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54, 0x45, 0x41, 0x4d, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x41, 0x46, 0x49, 0x41, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
+	0x43, 0x49, 0x56, 0x49, 0x4c, 0x49, 0x41, 0x4e, 0x53, 0x10, 0x02, 0x32, 0xaf, 0x0a, 0x0a, 0x05,
+	0x4d, 0x61, 0x66, 0x69, 0x61, 0x12, 0x39, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x36, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x66, 0x69,
+	0x61, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x35, 0x0a, 0x06, 0x52,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x52, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x61,
+	0x66, 0x69, 0x61, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x30, 0x01, 0x12, 0x2a, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12,
+	0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e,
+	0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e,
+	0x0a, 0x07, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x2e, 0x6d, 0x61, 0x66, 0x69,
+	0x61, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e,
+	0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x12, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x6d,
+	0x61, 0x66, 0x69, 0x61, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x19, 0x2e,
+	0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
+	0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x28, 0x0a, 0x04,
+	0x56, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x56, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12,
+	0x13, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0c, 0x2e,
+	0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x6d, 0x61,
+	0x66, 0x69, 0x61, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x2d, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x13, 0x2e, 0x6d,
+	0x61, 0x66, 0x69, 0x61, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x27, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x2e, 0x6d,
+	0x61, 0x66, 0x69, 0x61, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0c, 0x2e, 0x6d, 0x61, 0x66,
+	0x69, 0x61, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x6d, 0x61,
+	0x66, 0x69, 0x61, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69,
+	0x61, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x53,
+	0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e,
+	0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x38, 0x0a, 0x0f, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x66, 0x69,
+	0x61, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x39, 0x0a, 0x08, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x6d,
+	0x61, 0x66, 0x69, 0x61, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x28, 0x0a, 0x0a, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x12, 0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69,
+	0x61, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x72, 0x12, 0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x2a, 0x0a, 0x0c, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x68, 0x61, 0x73, 0x65,
+	0x12, 0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0c,
+	0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x0a,
+	0x4b, 0x69, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x66,
+	0x69, 0x61, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x35, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x69, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x6d, 0x61, 0x66,
+	0x69, 0x61, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x0a, 0x4b, 0x69, 0x63, 0x6b,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x4d,
+	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xb2, 0x02,
+	0x0a, 0x0a, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x30, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0c, 0x2e, 0x6d,
+	0x61, 0x66, 0x69, 0x61, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x6d, 0x61, 0x66,
+	0x69, 0x61, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x41,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x6d,
+	0x61, 0x66, 0x69, 0x61, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x3c, 0x0a, 0x10, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x32, 0x0a, 0x05, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x13, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61,
-	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
-	0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x0d, 0x5a, 0x0b, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x33, 0x0a, 0x0a, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x17, 0x2e,
+	0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4b, 0x69, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x3c, 0x0a, 0x0e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x42, 0x0d, 0x5a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1068,55 +4556,198 @@ func file_mafia_proto_rawDescGZIP() []byte {
 	return file_mafia_proto_rawDescData
 }
 
-var file_mafia_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_mafia_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_mafia_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_mafia_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_mafia_proto_goTypes = []interface{}{
-	(Role)(0),                              // 0: mafia.Role
-	(Team)(0),                              // 1: mafia.Team
-	(*Empty)(nil),                          // 2: mafia.Empty
-	(*StartSessionRequest)(nil),            // 3: mafia.StartSessionRequest
-	(*VoteRequest)(nil),                    // 4: mafia.VoteRequest
-	(*ShootRequest)(nil),                   // 5: mafia.ShootRequest
-	(*CheckRequest)(nil),                   // 6: mafia.CheckRequest
-	(*CheckResponse)(nil),                  // 7: mafia.CheckResponse
-	(*Player)(nil),                         // 8: mafia.Player
-	(*SessionState)(nil),                   // 9: mafia.SessionState
-	(*SessionEvent)(nil),                   // 10: mafia.SessionEvent
-	(*SessionEvent_SessionStartInfo)(nil),  // 11: mafia.SessionEvent.SessionStartInfo
-	(*SessionEvent_SessionFinishInfo)(nil), // 12: mafia.SessionEvent.SessionFinishInfo
-	(*SessionEvent_PlayerJoinInfo)(nil),    // 13: mafia.SessionEvent.PlayerJoinInfo
-	(*SessionEvent_PlayerLeftInfo)(nil),    // 14: mafia.SessionEvent.PlayerLeftInfo
-	(*SessionEvent_VoteInfo)(nil),          // 15: mafia.SessionEvent.VoteInfo
-	(*SessionEvent_ShootInfo)(nil),         // 16: mafia.SessionEvent.ShootInfo
+	(Role)(0),                                // 0: mafia.Role
+	(Phase)(0),                               // 1: mafia.Phase
+	(ChatChannel)(0),                         // 2: mafia.ChatChannel
+	(NightAction)(0),                         // 3: mafia.NightAction
+	(ModeratorAction)(0),                     // 4: mafia.ModeratorAction
+	(Team)(0),                                // 5: mafia.Team
+	(*Empty)(nil),                            // 6: mafia.Empty
+	(*AccountRequest)(nil),                   // 7: mafia.AccountRequest
+	(*AccountResponse)(nil),                  // 8: mafia.AccountResponse
+	(*StartSessionRequest)(nil),              // 9: mafia.StartSessionRequest
+	(*ResumeRequest)(nil),                    // 10: mafia.ResumeRequest
+	(*GameRequest)(nil),                      // 11: mafia.GameRequest
+	(*PlayerStatsRequest)(nil),               // 12: mafia.PlayerStatsRequest
+	(*LeaderboardRequest)(nil),               // 13: mafia.LeaderboardRequest
+	(*VoteRequest)(nil),                      // 14: mafia.VoteRequest
+	(*ShootRequest)(nil),                     // 15: mafia.ShootRequest
+	(*ReadyRequest)(nil),                     // 16: mafia.ReadyRequest
+	(*ChatRequest)(nil),                      // 17: mafia.ChatRequest
+	(*SpectateRequest)(nil),                  // 18: mafia.SpectateRequest
+	(*ModerateRequest)(nil),                  // 19: mafia.ModerateRequest
+	(*ModeratorRequest)(nil),                 // 20: mafia.ModeratorRequest
+	(*CheckRequest)(nil),                     // 21: mafia.CheckRequest
+	(*CheckResponse)(nil),                    // 22: mafia.CheckResponse
+	(*Player)(nil),                           // 23: mafia.Player
+	(*SessionState)(nil),                     // 24: mafia.SessionState
+	(*SessionEvent)(nil),                     // 25: mafia.SessionEvent
+	(*AdminSessionRequest)(nil),              // 26: mafia.AdminSessionRequest
+	(*AdminKickRequest)(nil),                 // 27: mafia.AdminKickRequest
+	(*AdminModeratorRequest)(nil),            // 28: mafia.AdminModeratorRequest
+	(*SessionSummary)(nil),                   // 29: mafia.SessionSummary
+	(*SessionList)(nil),                      // 30: mafia.SessionList
+	(*AdminSessionInfo)(nil),                 // 31: mafia.AdminSessionInfo
+	(*GameSummary)(nil),                      // 32: mafia.GameSummary
+	(*GameList)(nil),                         // 33: mafia.GameList
+	(*GameInfo)(nil),                         // 34: mafia.GameInfo
+	(*GameTimeline)(nil),                     // 35: mafia.GameTimeline
+	(*PlayerStats)(nil),                      // 36: mafia.PlayerStats
+	(*Leaderboard)(nil),                      // 37: mafia.Leaderboard
+	(*WebSocketAction)(nil),                  // 38: mafia.WebSocketAction
+	(*WebSocketFrame)(nil),                   // 39: mafia.WebSocketFrame
+	(*SessionEvent_SessionStartInfo)(nil),    // 40: mafia.SessionEvent.SessionStartInfo
+	(*SessionEvent_SessionFinishInfo)(nil),   // 41: mafia.SessionEvent.SessionFinishInfo
+	(*SessionEvent_PlayerJoinInfo)(nil),      // 42: mafia.SessionEvent.PlayerJoinInfo
+	(*SessionEvent_PlayerLeftInfo)(nil),      // 43: mafia.SessionEvent.PlayerLeftInfo
+	(*SessionEvent_VoteInfo)(nil),            // 44: mafia.SessionEvent.VoteInfo
+	(*SessionEvent_LobbyPlayer)(nil),         // 45: mafia.SessionEvent.LobbyPlayer
+	(*SessionEvent_LobbyUpdateInfo)(nil),     // 46: mafia.SessionEvent.LobbyUpdateInfo
+	(*SessionEvent_PhaseInfo)(nil),           // 47: mafia.SessionEvent.PhaseInfo
+	(*SessionEvent_ModeratorActionInfo)(nil), // 48: mafia.SessionEvent.ModeratorActionInfo
+	(*SessionEvent_ChatInfo)(nil),            // 49: mafia.SessionEvent.ChatInfo
+	(*SessionEvent_NightActionInfo)(nil),     // 50: mafia.SessionEvent.NightActionInfo
+	(*SessionEvent_ServerShutdownInfo)(nil),  // 51: mafia.SessionEvent.ServerShutdownInfo
+	(*AdminSessionInfo_Vote)(nil),            // 52: mafia.AdminSessionInfo.Vote
+	(*GameInfo_Vote)(nil),                    // 53: mafia.GameInfo.Vote
+	(*GameInfo_Check)(nil),                   // 54: mafia.GameInfo.Check
+	(*GameInfo_PhaseRecord)(nil),             // 55: mafia.GameInfo.PhaseRecord
+	(*GameTimeline_Event)(nil),               // 56: mafia.GameTimeline.Event
+	(*PlayerStats_RoleStats)(nil),            // 57: mafia.PlayerStats.RoleStats
+	(*PlayerStats_TeamStats)(nil),            // 58: mafia.PlayerStats.TeamStats
+	(*Leaderboard_Entry)(nil),                // 59: mafia.Leaderboard.Entry
+	(*WebSocketFrame_Error)(nil),             // 60: mafia.WebSocketFrame.Error
 }
 var file_mafia_proto_depIdxs = []int32{
 	0,  // 0: mafia.CheckResponse.role:type_name -> mafia.Role
 	0,  // 1: mafia.Player.role:type_name -> mafia.Role
-	8,  // 2: mafia.SessionState.player:type_name -> mafia.Player
-	8,  // 3: mafia.SessionState.players:type_name -> mafia.Player
-	1,  // 4: mafia.SessionState.winnerTeam:type_name -> mafia.Team
-	11, // 5: mafia.SessionEvent.startInfo:type_name -> mafia.SessionEvent.SessionStartInfo
-	12, // 6: mafia.SessionEvent.finishInfo:type_name -> mafia.SessionEvent.SessionFinishInfo
-	13, // 7: mafia.SessionEvent.joinInfo:type_name -> mafia.SessionEvent.PlayerJoinInfo
-	14, // 8: mafia.SessionEvent.leftInfo:type_name -> mafia.SessionEvent.PlayerLeftInfo
-	15, // 9: mafia.SessionEvent.voteInfo:type_name -> mafia.SessionEvent.VoteInfo
-	0,  // 10: mafia.SessionEvent.SessionStartInfo.role:type_name -> mafia.Role
-	8,  // 11: mafia.SessionEvent.SessionStartInfo.players:type_name -> mafia.Player
-	1,  // 12: mafia.SessionEvent.SessionFinishInfo.winners:type_name -> mafia.Team
-	8,  // 13: mafia.SessionEvent.SessionFinishInfo.players:type_name -> mafia.Player
-	3,  // 14: mafia.Mafia.StartSession:input_type -> mafia.StartSessionRequest
-	4,  // 15: mafia.Mafia.Vote:input_type -> mafia.VoteRequest
-	6,  // 16: mafia.Mafia.Check:input_type -> mafia.CheckRequest
-	2,  // 17: mafia.Mafia.GetSessionState:input_type -> mafia.Empty
-	10, // 18: mafia.Mafia.StartSession:output_type -> mafia.SessionEvent
-	2,  // 19: mafia.Mafia.Vote:output_type -> mafia.Empty
-	7,  // 20: mafia.Mafia.Check:output_type -> mafia.CheckResponse
-	9,  // 21: mafia.Mafia.GetSessionState:output_type -> mafia.SessionState
-	18, // [18:22] is the sub-list for method output_type
-	14, // [14:18] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	23, // 2: mafia.SessionState.player:type_name -> mafia.Player
+	23, // 3: mafia.SessionState.players:type_name -> mafia.Player
+	5,  // 4: mafia.SessionState.winnerTeam:type_name -> mafia.Team
+	1,  // 5: mafia.SessionState.phase:type_name -> mafia.Phase
+	40, // 6: mafia.SessionEvent.startInfo:type_name -> mafia.SessionEvent.SessionStartInfo
+	41, // 7: mafia.SessionEvent.finishInfo:type_name -> mafia.SessionEvent.SessionFinishInfo
+	42, // 8: mafia.SessionEvent.joinInfo:type_name -> mafia.SessionEvent.PlayerJoinInfo
+	43, // 9: mafia.SessionEvent.leftInfo:type_name -> mafia.SessionEvent.PlayerLeftInfo
+	44, // 10: mafia.SessionEvent.voteInfo:type_name -> mafia.SessionEvent.VoteInfo
+	46, // 11: mafia.SessionEvent.lobbyUpdate:type_name -> mafia.SessionEvent.LobbyUpdateInfo
+	47, // 12: mafia.SessionEvent.phaseInfo:type_name -> mafia.SessionEvent.PhaseInfo
+	49, // 13: mafia.SessionEvent.chatInfo:type_name -> mafia.SessionEvent.ChatInfo
+	50, // 14: mafia.SessionEvent.nightActionInfo:type_name -> mafia.SessionEvent.NightActionInfo
+	48, // 15: mafia.SessionEvent.moderatorActionInfo:type_name -> mafia.SessionEvent.ModeratorActionInfo
+	51, // 16: mafia.SessionEvent.shutdownInfo:type_name -> mafia.SessionEvent.ServerShutdownInfo
+	1,  // 17: mafia.SessionSummary.phase:type_name -> mafia.Phase
+	29, // 18: mafia.SessionList.sessions:type_name -> mafia.SessionSummary
+	1,  // 19: mafia.AdminSessionInfo.phase:type_name -> mafia.Phase
+	5,  // 20: mafia.AdminSessionInfo.winnerTeam:type_name -> mafia.Team
+	23, // 21: mafia.AdminSessionInfo.players:type_name -> mafia.Player
+	52, // 22: mafia.AdminSessionInfo.votes:type_name -> mafia.AdminSessionInfo.Vote
+	5,  // 23: mafia.GameSummary.winner:type_name -> mafia.Team
+	0,  // 24: mafia.GameSummary.role:type_name -> mafia.Role
+	32, // 25: mafia.GameList.games:type_name -> mafia.GameSummary
+	5,  // 26: mafia.GameInfo.winner:type_name -> mafia.Team
+	23, // 27: mafia.GameInfo.players:type_name -> mafia.Player
+	55, // 28: mafia.GameInfo.phases:type_name -> mafia.GameInfo.PhaseRecord
+	56, // 29: mafia.GameTimeline.events:type_name -> mafia.GameTimeline.Event
+	57, // 30: mafia.PlayerStats.roles:type_name -> mafia.PlayerStats.RoleStats
+	58, // 31: mafia.PlayerStats.teams:type_name -> mafia.PlayerStats.TeamStats
+	59, // 32: mafia.Leaderboard.entries:type_name -> mafia.Leaderboard.Entry
+	14, // 33: mafia.WebSocketAction.vote:type_name -> mafia.VoteRequest
+	21, // 34: mafia.WebSocketAction.check:type_name -> mafia.CheckRequest
+	16, // 35: mafia.WebSocketAction.ready:type_name -> mafia.ReadyRequest
+	6,  // 36: mafia.WebSocketAction.startGame:type_name -> mafia.Empty
+	17, // 37: mafia.WebSocketAction.message:type_name -> mafia.ChatRequest
+	6,  // 38: mafia.WebSocketAction.getState:type_name -> mafia.Empty
+	25, // 39: mafia.WebSocketFrame.event:type_name -> mafia.SessionEvent
+	6,  // 40: mafia.WebSocketFrame.ok:type_name -> mafia.Empty
+	24, // 41: mafia.WebSocketFrame.state:type_name -> mafia.SessionState
+	22, // 42: mafia.WebSocketFrame.checkResult:type_name -> mafia.CheckResponse
+	60, // 43: mafia.WebSocketFrame.error:type_name -> mafia.WebSocketFrame.Error
+	0,  // 44: mafia.SessionEvent.SessionStartInfo.role:type_name -> mafia.Role
+	23, // 45: mafia.SessionEvent.SessionStartInfo.players:type_name -> mafia.Player
+	5,  // 46: mafia.SessionEvent.SessionFinishInfo.winners:type_name -> mafia.Team
+	23, // 47: mafia.SessionEvent.SessionFinishInfo.players:type_name -> mafia.Player
+	45, // 48: mafia.SessionEvent.LobbyUpdateInfo.players:type_name -> mafia.SessionEvent.LobbyPlayer
+	1,  // 49: mafia.SessionEvent.PhaseInfo.phase:type_name -> mafia.Phase
+	4,  // 50: mafia.SessionEvent.ModeratorActionInfo.action:type_name -> mafia.ModeratorAction
+	2,  // 51: mafia.SessionEvent.ChatInfo.channel:type_name -> mafia.ChatChannel
+	3,  // 52: mafia.SessionEvent.NightActionInfo.action:type_name -> mafia.NightAction
+	0,  // 53: mafia.SessionEvent.NightActionInfo.targetRole:type_name -> mafia.Role
+	0,  // 54: mafia.GameInfo.Check.targetRole:type_name -> mafia.Role
+	1,  // 55: mafia.GameInfo.PhaseRecord.phase:type_name -> mafia.Phase
+	53, // 56: mafia.GameInfo.PhaseRecord.votes:type_name -> mafia.GameInfo.Vote
+	54, // 57: mafia.GameInfo.PhaseRecord.checks:type_name -> mafia.GameInfo.Check
+	25, // 58: mafia.GameTimeline.Event.event:type_name -> mafia.SessionEvent
+	0,  // 59: mafia.PlayerStats.RoleStats.role:type_name -> mafia.Role
+	5,  // 60: mafia.PlayerStats.TeamStats.team:type_name -> mafia.Team
+	7,  // 61: mafia.Mafia.Register:input_type -> mafia.AccountRequest
+	7,  // 62: mafia.Mafia.Login:input_type -> mafia.AccountRequest
+	9,  // 63: mafia.Mafia.StartSession:input_type -> mafia.StartSessionRequest
+	10, // 64: mafia.Mafia.Resume:input_type -> mafia.ResumeRequest
+	6,  // 65: mafia.Mafia.ListGames:input_type -> mafia.Empty
+	11, // 66: mafia.Mafia.GetGame:input_type -> mafia.GameRequest
+	11, // 67: mafia.Mafia.GetGameTimeline:input_type -> mafia.GameRequest
+	12, // 68: mafia.Mafia.GetPlayerStats:input_type -> mafia.PlayerStatsRequest
+	13, // 69: mafia.Mafia.GetLeaderboard:input_type -> mafia.LeaderboardRequest
+	14, // 70: mafia.Mafia.Vote:input_type -> mafia.VoteRequest
+	21, // 71: mafia.Mafia.Check:input_type -> mafia.CheckRequest
+	6,  // 72: mafia.Mafia.GetSessionState:input_type -> mafia.Empty
+	16, // 73: mafia.Mafia.SetReady:input_type -> mafia.ReadyRequest
+	6,  // 74: mafia.Mafia.StartGame:input_type -> mafia.Empty
+	17, // 75: mafia.Mafia.SendMessage:input_type -> mafia.ChatRequest
+	6,  // 76: mafia.Mafia.ListSessions:input_type -> mafia.Empty
+	18, // 77: mafia.Mafia.Spectate:input_type -> mafia.SpectateRequest
+	20, // 78: mafia.Mafia.InviteModerator:input_type -> mafia.ModeratorRequest
+	19, // 79: mafia.Mafia.Moderate:input_type -> mafia.ModerateRequest
+	6,  // 80: mafia.Mafia.PauseTimer:input_type -> mafia.Empty
+	6,  // 81: mafia.Mafia.ResumeTimer:input_type -> mafia.Empty
+	6,  // 82: mafia.Mafia.AdvancePhase:input_type -> mafia.Empty
+	20, // 83: mafia.Mafia.KillPlayer:input_type -> mafia.ModeratorRequest
+	20, // 84: mafia.Mafia.RevivePlayer:input_type -> mafia.ModeratorRequest
+	20, // 85: mafia.Mafia.KickPlayer:input_type -> mafia.ModeratorRequest
+	6,  // 86: mafia.MafiaAdmin.ListSessions:input_type -> mafia.Empty
+	26, // 87: mafia.MafiaAdmin.GetSession:input_type -> mafia.AdminSessionRequest
+	26, // 88: mafia.MafiaAdmin.TerminateSession:input_type -> mafia.AdminSessionRequest
+	27, // 89: mafia.MafiaAdmin.KickPlayer:input_type -> mafia.AdminKickRequest
+	28, // 90: mafia.MafiaAdmin.GrantModerator:input_type -> mafia.AdminModeratorRequest
+	8,  // 91: mafia.Mafia.Register:output_type -> mafia.AccountResponse
+	8,  // 92: mafia.Mafia.Login:output_type -> mafia.AccountResponse
+	25, // 93: mafia.Mafia.StartSession:output_type -> mafia.SessionEvent
+	25, // 94: mafia.Mafia.Resume:output_type -> mafia.SessionEvent
+	33, // 95: mafia.Mafia.ListGames:output_type -> mafia.GameList
+	34, // 96: mafia.Mafia.GetGame:output_type -> mafia.GameInfo
+	35, // 97: mafia.Mafia.GetGameTimeline:output_type -> mafia.GameTimeline
+	36, // 98: mafia.Mafia.GetPlayerStats:output_type -> mafia.PlayerStats
+	37, // 99: mafia.Mafia.GetLeaderboard:output_type -> mafia.Leaderboard
+	6,  // 100: mafia.Mafia.Vote:output_type -> mafia.Empty
+	22, // 101: mafia.Mafia.Check:output_type -> mafia.CheckResponse
+	24, // 102: mafia.Mafia.GetSessionState:output_type -> mafia.SessionState
+	6,  // 103: mafia.Mafia.SetReady:output_type -> mafia.Empty
+	6,  // 104: mafia.Mafia.StartGame:output_type -> mafia.Empty
+	6,  // 105: mafia.Mafia.SendMessage:output_type -> mafia.Empty
+	30, // 106: mafia.Mafia.ListSessions:output_type -> mafia.SessionList
+	25, // 107: mafia.Mafia.Spectate:output_type -> mafia.SessionEvent
+	6,  // 108: mafia.Mafia.InviteModerator:output_type -> mafia.Empty
+	25, // 109: mafia.Mafia.Moderate:output_type -> mafia.SessionEvent
+	6,  // 110: mafia.Mafia.PauseTimer:output_type -> mafia.Empty
+	6,  // 111: mafia.Mafia.ResumeTimer:output_type -> mafia.Empty
+	6,  // 112: mafia.Mafia.AdvancePhase:output_type -> mafia.Empty
+	6,  // 113: mafia.Mafia.KillPlayer:output_type -> mafia.Empty
+	6,  // 114: mafia.Mafia.RevivePlayer:output_type -> mafia.Empty
+	6,  // 115: mafia.Mafia.KickPlayer:output_type -> mafia.Empty
+	30, // 116: mafia.MafiaAdmin.ListSessions:output_type -> mafia.SessionList
+	31, // 117: mafia.MafiaAdmin.GetSession:output_type -> mafia.AdminSessionInfo
+	6,  // 118: mafia.MafiaAdmin.TerminateSession:output_type -> mafia.Empty
+	6,  // 119: mafia.MafiaAdmin.KickPlayer:output_type -> mafia.Empty
+	6,  // 120: mafia.MafiaAdmin.GrantModerator:output_type -> mafia.Empty
+	91, // [91:121] is the sub-list for method output_type
+	61, // [61:91] is the sub-list for method input_type
+	61, // [61:61] is the sub-list for extension type_name
+	61, // [61:61] is the sub-list for extension extendee
+	0,  // [0:61] is the sub-list for field type_name
 }
 
 func init() { file_mafia_proto_init() }
@@ -1137,8 +4768,212 @@ func file_mafia_proto_init() {
 				return nil
 			}
 		}
-		file_mafia_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartSessionRequest); i {
+		file_mafia_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderboardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShootRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpectateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModerateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModeratorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Player); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1149,8 +4984,8 @@ func file_mafia_proto_init() {
 				return nil
 			}
 		}
-		file_mafia_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VoteRequest); i {
+		file_mafia_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1161,8 +4996,8 @@ func file_mafia_proto_init() {
 				return nil
 			}
 		}
-		file_mafia_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShootRequest); i {
+		file_mafia_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1173,8 +5008,8 @@ func file_mafia_proto_init() {
 				return nil
 			}
 		}
-		file_mafia_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckRequest); i {
+		file_mafia_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminKickRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1185,8 +5020,8 @@ func file_mafia_proto_init() {
 				return nil
 			}
 		}
-		file_mafia_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckResponse); i {
+		file_mafia_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminModeratorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1197,8 +5032,8 @@ func file_mafia_proto_init() {
 				return nil
 			}
 		}
-		file_mafia_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Player); i {
+		file_mafia_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1209,8 +5044,8 @@ func file_mafia_proto_init() {
 				return nil
 			}
 		}
-		file_mafia_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SessionState); i {
+		file_mafia_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1221,8 +5056,8 @@ func file_mafia_proto_init() {
 				return nil
 			}
 		}
-		file_mafia_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SessionEvent); i {
+		file_mafia_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSessionInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1233,7 +5068,103 @@ func file_mafia_proto_init() {
 				return nil
 			}
 		}
-		file_mafia_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_mafia_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameTimeline); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Leaderboard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebSocketAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebSocketFrame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SessionEvent_SessionStartInfo); i {
 			case 0:
 				return &v.state
@@ -1245,7 +5176,7 @@ func file_mafia_proto_init() {
 				return nil
 			}
 		}
-		file_mafia_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_mafia_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SessionEvent_SessionFinishInfo); i {
 			case 0:
 				return &v.state
@@ -1257,7 +5188,7 @@ func file_mafia_proto_init() {
 				return nil
 			}
 		}
-		file_mafia_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_mafia_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SessionEvent_PlayerJoinInfo); i {
 			case 0:
 				return &v.state
@@ -1269,7 +5200,7 @@ func file_mafia_proto_init() {
 				return nil
 			}
 		}
-		file_mafia_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_mafia_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SessionEvent_PlayerLeftInfo); i {
 			case 0:
 				return &v.state
@@ -1281,7 +5212,7 @@ func file_mafia_proto_init() {
 				return nil
 			}
 		}
-		file_mafia_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_mafia_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SessionEvent_VoteInfo); i {
 			case 0:
 				return &v.state
@@ -1293,8 +5224,188 @@ func file_mafia_proto_init() {
 				return nil
 			}
 		}
-		file_mafia_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SessionEvent_ShootInfo); i {
+		file_mafia_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionEvent_LobbyPlayer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionEvent_LobbyUpdateInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionEvent_PhaseInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionEvent_ModeratorActionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionEvent_ChatInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionEvent_NightActionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionEvent_ServerShutdownInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSessionInfo_Vote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameInfo_Vote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameInfo_Check); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameInfo_PhaseRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameTimeline_Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerStats_RoleStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerStats_TeamStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Leaderboard_Entry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mafia_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebSocketFrame_Error); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1306,22 +5417,44 @@ func file_mafia_proto_init() {
 			}
 		}
 	}
-	file_mafia_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_mafia_proto_msgTypes[19].OneofWrappers = []interface{}{
 		(*SessionEvent_StartInfo)(nil),
 		(*SessionEvent_FinishInfo)(nil),
 		(*SessionEvent_JoinInfo)(nil),
 		(*SessionEvent_LeftInfo)(nil),
 		(*SessionEvent_VoteInfo_)(nil),
+		(*SessionEvent_LobbyUpdate)(nil),
+		(*SessionEvent_PhaseInfo_)(nil),
+		(*SessionEvent_ChatInfo_)(nil),
+		(*SessionEvent_NightActionInfo_)(nil),
+		(*SessionEvent_ModeratorActionInfo_)(nil),
+		(*SessionEvent_ShutdownInfo)(nil),
+	}
+	file_mafia_proto_msgTypes[32].OneofWrappers = []interface{}{
+		(*WebSocketAction_Vote)(nil),
+		(*WebSocketAction_Check)(nil),
+		(*WebSocketAction_Ready)(nil),
+		(*WebSocketAction_StartGame)(nil),
+		(*WebSocketAction_Message)(nil),
+		(*WebSocketAction_GetState)(nil),
+	}
+	file_mafia_proto_msgTypes[33].OneofWrappers = []interface{}{
+		(*WebSocketFrame_Event)(nil),
+		(*WebSocketFrame_Token)(nil),
+		(*WebSocketFrame_Ok)(nil),
+		(*WebSocketFrame_State)(nil),
+		(*WebSocketFrame_CheckResult)(nil),
+		(*WebSocketFrame_Error_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mafia_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   15,
+			NumEnums:      6,
+			NumMessages:   55,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_mafia_proto_goTypes,
 		DependencyIndexes: file_mafia_proto_depIdxs,
@@ -22,10 +22,31 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MafiaClient interface {
+	Register(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountResponse, error)
+	Login(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountResponse, error)
 	StartSession(ctx context.Context, in *StartSessionRequest, opts ...grpc.CallOption) (Mafia_StartSessionClient, error)
+	Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (Mafia_ResumeClient, error)
+	ListGames(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GameList, error)
+	GetGame(ctx context.Context, in *GameRequest, opts ...grpc.CallOption) (*GameInfo, error)
+	GetGameTimeline(ctx context.Context, in *GameRequest, opts ...grpc.CallOption) (*GameTimeline, error)
+	GetPlayerStats(ctx context.Context, in *PlayerStatsRequest, opts ...grpc.CallOption) (*PlayerStats, error)
+	GetLeaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*Leaderboard, error)
 	Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*Empty, error)
 	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error)
 	GetSessionState(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SessionState, error)
+	SetReady(ctx context.Context, in *ReadyRequest, opts ...grpc.CallOption) (*Empty, error)
+	StartGame(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	SendMessage(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListSessions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SessionList, error)
+	Spectate(ctx context.Context, in *SpectateRequest, opts ...grpc.CallOption) (Mafia_SpectateClient, error)
+	InviteModerator(ctx context.Context, in *ModeratorRequest, opts ...grpc.CallOption) (*Empty, error)
+	Moderate(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (Mafia_ModerateClient, error)
+	PauseTimer(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	ResumeTimer(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	AdvancePhase(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	KillPlayer(ctx context.Context, in *ModeratorRequest, opts ...grpc.CallOption) (*Empty, error)
+	RevivePlayer(ctx context.Context, in *ModeratorRequest, opts ...grpc.CallOption) (*Empty, error)
+	KickPlayer(ctx context.Context, in *ModeratorRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type mafiaClient struct {
@@ -36,6 +57,24 @@ func NewMafiaClient(cc grpc.ClientConnInterface) MafiaClient {
 	return &mafiaClient{cc}
 }
 
+func (c *mafiaClient) Register(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountResponse, error) {
+	out := new(AccountResponse)
+	err := c.cc.Invoke(ctx, "/mafia.Mafia/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaClient) Login(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountResponse, error) {
+	out := new(AccountResponse)
+	err := c.cc.Invoke(ctx, "/mafia.Mafia/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mafiaClient) StartSession(ctx context.Context, in *StartSessionRequest, opts ...grpc.CallOption) (Mafia_StartSessionClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Mafia_ServiceDesc.Streams[0], "/mafia.Mafia/StartSession", opts...)
 	if err != nil {
@@ -68,6 +107,83 @@ func (x *mafiaStartSessionClient) Recv() (*SessionEvent, error) {
 	return m, nil
 }
 
+func (c *mafiaClient) Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (Mafia_ResumeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Mafia_ServiceDesc.Streams[1], "/mafia.Mafia/Resume", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mafiaResumeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Mafia_ResumeClient interface {
+	Recv() (*SessionEvent, error)
+	grpc.ClientStream
+}
+
+type mafiaResumeClient struct {
+	grpc.ClientStream
+}
+
+func (x *mafiaResumeClient) Recv() (*SessionEvent, error) {
+	m := new(SessionEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mafiaClient) ListGames(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GameList, error) {
+	out := new(GameList)
+	err := c.cc.Invoke(ctx, "/mafia.Mafia/ListGames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaClient) GetGame(ctx context.Context, in *GameRequest, opts ...grpc.CallOption) (*GameInfo, error) {
+	out := new(GameInfo)
+	err := c.cc.Invoke(ctx, "/mafia.Mafia/GetGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaClient) GetGameTimeline(ctx context.Context, in *GameRequest, opts ...grpc.CallOption) (*GameTimeline, error) {
+	out := new(GameTimeline)
+	err := c.cc.Invoke(ctx, "/mafia.Mafia/GetGameTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaClient) GetPlayerStats(ctx context.Context, in *PlayerStatsRequest, opts ...grpc.CallOption) (*PlayerStats, error) {
+	out := new(PlayerStats)
+	err := c.cc.Invoke(ctx, "/mafia.Mafia/GetPlayerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaClient) GetLeaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*Leaderboard, error) {
+	out := new(Leaderboard)
+	err := c.cc.Invoke(ctx, "/mafia.Mafia/GetLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mafiaClient) Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, "/mafia.Mafia/Vote", in, out, opts...)
@@ -95,14 +211,198 @@ func (c *mafiaClient) GetSessionState(ctx context.Context, in *Empty, opts ...gr
 	return out, nil
 }
 
+func (c *mafiaClient) SetReady(ctx context.Context, in *ReadyRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mafia.Mafia/SetReady", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaClient) StartGame(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mafia.Mafia/StartGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaClient) SendMessage(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mafia.Mafia/SendMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaClient) ListSessions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SessionList, error) {
+	out := new(SessionList)
+	err := c.cc.Invoke(ctx, "/mafia.Mafia/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaClient) Spectate(ctx context.Context, in *SpectateRequest, opts ...grpc.CallOption) (Mafia_SpectateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Mafia_ServiceDesc.Streams[2], "/mafia.Mafia/Spectate", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mafiaSpectateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Mafia_SpectateClient interface {
+	Recv() (*SessionEvent, error)
+	grpc.ClientStream
+}
+
+type mafiaSpectateClient struct {
+	grpc.ClientStream
+}
+
+func (x *mafiaSpectateClient) Recv() (*SessionEvent, error) {
+	m := new(SessionEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mafiaClient) InviteModerator(ctx context.Context, in *ModeratorRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mafia.Mafia/InviteModerator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaClient) Moderate(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (Mafia_ModerateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Mafia_ServiceDesc.Streams[3], "/mafia.Mafia/Moderate", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mafiaModerateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Mafia_ModerateClient interface {
+	Recv() (*SessionEvent, error)
+	grpc.ClientStream
+}
+
+type mafiaModerateClient struct {
+	grpc.ClientStream
+}
+
+func (x *mafiaModerateClient) Recv() (*SessionEvent, error) {
+	m := new(SessionEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mafiaClient) PauseTimer(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mafia.Mafia/PauseTimer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaClient) ResumeTimer(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mafia.Mafia/ResumeTimer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaClient) AdvancePhase(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mafia.Mafia/AdvancePhase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaClient) KillPlayer(ctx context.Context, in *ModeratorRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mafia.Mafia/KillPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaClient) RevivePlayer(ctx context.Context, in *ModeratorRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mafia.Mafia/RevivePlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaClient) KickPlayer(ctx context.Context, in *ModeratorRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mafia.Mafia/KickPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MafiaServer is the server API for Mafia service.
 // All implementations must embed UnimplementedMafiaServer
 // for forward compatibility
 type MafiaServer interface {
+	Register(context.Context, *AccountRequest) (*AccountResponse, error)
+	Login(context.Context, *AccountRequest) (*AccountResponse, error)
 	StartSession(*StartSessionRequest, Mafia_StartSessionServer) error
+	Resume(*ResumeRequest, Mafia_ResumeServer) error
+	ListGames(context.Context, *Empty) (*GameList, error)
+	GetGame(context.Context, *GameRequest) (*GameInfo, error)
+	GetGameTimeline(context.Context, *GameRequest) (*GameTimeline, error)
+	GetPlayerStats(context.Context, *PlayerStatsRequest) (*PlayerStats, error)
+	GetLeaderboard(context.Context, *LeaderboardRequest) (*Leaderboard, error)
 	Vote(context.Context, *VoteRequest) (*Empty, error)
 	Check(context.Context, *CheckRequest) (*CheckResponse, error)
 	GetSessionState(context.Context, *Empty) (*SessionState, error)
+	SetReady(context.Context, *ReadyRequest) (*Empty, error)
+	StartGame(context.Context, *Empty) (*Empty, error)
+	SendMessage(context.Context, *ChatRequest) (*Empty, error)
+	ListSessions(context.Context, *Empty) (*SessionList, error)
+	Spectate(*SpectateRequest, Mafia_SpectateServer) error
+	InviteModerator(context.Context, *ModeratorRequest) (*Empty, error)
+	Moderate(*ModerateRequest, Mafia_ModerateServer) error
+	PauseTimer(context.Context, *Empty) (*Empty, error)
+	ResumeTimer(context.Context, *Empty) (*Empty, error)
+	AdvancePhase(context.Context, *Empty) (*Empty, error)
+	KillPlayer(context.Context, *ModeratorRequest) (*Empty, error)
+	RevivePlayer(context.Context, *ModeratorRequest) (*Empty, error)
+	KickPlayer(context.Context, *ModeratorRequest) (*Empty, error)
 	mustEmbedUnimplementedMafiaServer()
 }
 
@@ -110,9 +410,33 @@ type MafiaServer interface {
 type UnimplementedMafiaServer struct {
 }
 
+func (UnimplementedMafiaServer) Register(context.Context, *AccountRequest) (*AccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedMafiaServer) Login(context.Context, *AccountRequest) (*AccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
 func (UnimplementedMafiaServer) StartSession(*StartSessionRequest, Mafia_StartSessionServer) error {
 	return status.Errorf(codes.Unimplemented, "method StartSession not implemented")
 }
+func (UnimplementedMafiaServer) Resume(*ResumeRequest, Mafia_ResumeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
+func (UnimplementedMafiaServer) ListGames(context.Context, *Empty) (*GameList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGames not implemented")
+}
+func (UnimplementedMafiaServer) GetGame(context.Context, *GameRequest) (*GameInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGame not implemented")
+}
+func (UnimplementedMafiaServer) GetGameTimeline(context.Context, *GameRequest) (*GameTimeline, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameTimeline not implemented")
+}
+func (UnimplementedMafiaServer) GetPlayerStats(context.Context, *PlayerStatsRequest) (*PlayerStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerStats not implemented")
+}
+func (UnimplementedMafiaServer) GetLeaderboard(context.Context, *LeaderboardRequest) (*Leaderboard, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
 func (UnimplementedMafiaServer) Vote(context.Context, *VoteRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
@@ -122,6 +446,45 @@ func (UnimplementedMafiaServer) Check(context.Context, *CheckRequest) (*CheckRes
 func (UnimplementedMafiaServer) GetSessionState(context.Context, *Empty) (*SessionState, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSessionState not implemented")
 }
+func (UnimplementedMafiaServer) SetReady(context.Context, *ReadyRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReady not implemented")
+}
+func (UnimplementedMafiaServer) StartGame(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartGame not implemented")
+}
+func (UnimplementedMafiaServer) SendMessage(context.Context, *ChatRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
+}
+func (UnimplementedMafiaServer) ListSessions(context.Context, *Empty) (*SessionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedMafiaServer) Spectate(*SpectateRequest, Mafia_SpectateServer) error {
+	return status.Errorf(codes.Unimplemented, "method Spectate not implemented")
+}
+func (UnimplementedMafiaServer) InviteModerator(context.Context, *ModeratorRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteModerator not implemented")
+}
+func (UnimplementedMafiaServer) Moderate(*ModerateRequest, Mafia_ModerateServer) error {
+	return status.Errorf(codes.Unimplemented, "method Moderate not implemented")
+}
+func (UnimplementedMafiaServer) PauseTimer(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseTimer not implemented")
+}
+func (UnimplementedMafiaServer) ResumeTimer(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTimer not implemented")
+}
+func (UnimplementedMafiaServer) AdvancePhase(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdvancePhase not implemented")
+}
+func (UnimplementedMafiaServer) KillPlayer(context.Context, *ModeratorRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillPlayer not implemented")
+}
+func (UnimplementedMafiaServer) RevivePlayer(context.Context, *ModeratorRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevivePlayer not implemented")
+}
+func (UnimplementedMafiaServer) KickPlayer(context.Context, *ModeratorRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickPlayer not implemented")
+}
 func (UnimplementedMafiaServer) mustEmbedUnimplementedMafiaServer() {}
 
 // UnsafeMafiaServer may be embedded to opt out of forward compatibility for this service.
@@ -135,67 +498,214 @@ func RegisterMafiaServer(s grpc.ServiceRegistrar, srv MafiaServer) {
 	s.RegisterService(&Mafia_ServiceDesc, srv)
 }
 
-func _Mafia_StartSession_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StartSessionRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(MafiaServer).StartSession(m, &mafiaStartSessionServer{stream})
-}
-
-type Mafia_StartSessionServer interface {
-	Send(*SessionEvent) error
-	grpc.ServerStream
-}
-
-type mafiaStartSessionServer struct {
-	grpc.ServerStream
-}
-
-func (x *mafiaStartSessionServer) Send(m *SessionEvent) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _Mafia_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VoteRequest)
+func _Mafia_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MafiaServer).Vote(ctx, in)
+		return srv.(MafiaServer).Register(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/mafia.Mafia/Vote",
+		FullMethod: "/mafia.Mafia/Register",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MafiaServer).Vote(ctx, req.(*VoteRequest))
+		return srv.(MafiaServer).Register(ctx, req.(*AccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mafia_Check_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckRequest)
+func _Mafia_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MafiaServer).Check(ctx, in)
+		return srv.(MafiaServer).Login(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/mafia.Mafia/Check",
+		FullMethod: "/mafia.Mafia/Login",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MafiaServer).Check(ctx, req.(*CheckRequest))
+		return srv.(MafiaServer).Login(ctx, req.(*AccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mafia_GetSessionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+func _Mafia_StartSession_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StartSessionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MafiaServer).StartSession(m, &mafiaStartSessionServer{stream})
+}
+
+type Mafia_StartSessionServer interface {
+	Send(*SessionEvent) error
+	grpc.ServerStream
+}
+
+type mafiaStartSessionServer struct {
+	grpc.ServerStream
+}
+
+func (x *mafiaStartSessionServer) Send(m *SessionEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Mafia_Resume_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResumeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MafiaServer).Resume(m, &mafiaResumeServer{stream})
+}
+
+type Mafia_ResumeServer interface {
+	Send(*SessionEvent) error
+	grpc.ServerStream
+}
+
+type mafiaResumeServer struct {
+	grpc.ServerStream
+}
+
+func (x *mafiaResumeServer) Send(m *SessionEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Mafia_ListGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServer).ListGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.Mafia/ListGames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServer).ListGames(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mafia_GetGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServer).GetGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.Mafia/GetGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServer).GetGame(ctx, req.(*GameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mafia_GetGameTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServer).GetGameTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.Mafia/GetGameTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServer).GetGameTimeline(ctx, req.(*GameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mafia_GetPlayerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServer).GetPlayerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.Mafia/GetPlayerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServer).GetPlayerStats(ctx, req.(*PlayerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mafia_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.Mafia/GetLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServer).GetLeaderboard(ctx, req.(*LeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mafia_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServer).Vote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.Mafia/Vote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServer).Vote(ctx, req.(*VoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mafia_Check_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServer).Check(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.Mafia/Check",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServer).Check(ctx, req.(*CheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mafia_GetSessionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
 	if interceptor == nil {
 		return srv.(MafiaServer).GetSessionState(ctx, in)
@@ -210,6 +720,246 @@ func _Mafia_GetSessionState_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mafia_SetReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServer).SetReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.Mafia/SetReady",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServer).SetReady(ctx, req.(*ReadyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mafia_StartGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServer).StartGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.Mafia/StartGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServer).StartGame(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mafia_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServer).SendMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.Mafia/SendMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServer).SendMessage(ctx, req.(*ChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mafia_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.Mafia/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServer).ListSessions(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mafia_Spectate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SpectateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MafiaServer).Spectate(m, &mafiaSpectateServer{stream})
+}
+
+type Mafia_SpectateServer interface {
+	Send(*SessionEvent) error
+	grpc.ServerStream
+}
+
+type mafiaSpectateServer struct {
+	grpc.ServerStream
+}
+
+func (x *mafiaSpectateServer) Send(m *SessionEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Mafia_InviteModerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModeratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServer).InviteModerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.Mafia/InviteModerator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServer).InviteModerator(ctx, req.(*ModeratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mafia_Moderate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ModerateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MafiaServer).Moderate(m, &mafiaModerateServer{stream})
+}
+
+type Mafia_ModerateServer interface {
+	Send(*SessionEvent) error
+	grpc.ServerStream
+}
+
+type mafiaModerateServer struct {
+	grpc.ServerStream
+}
+
+func (x *mafiaModerateServer) Send(m *SessionEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Mafia_PauseTimer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServer).PauseTimer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.Mafia/PauseTimer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServer).PauseTimer(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mafia_ResumeTimer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServer).ResumeTimer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.Mafia/ResumeTimer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServer).ResumeTimer(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mafia_AdvancePhase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServer).AdvancePhase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.Mafia/AdvancePhase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServer).AdvancePhase(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mafia_KillPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModeratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServer).KillPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.Mafia/KillPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServer).KillPlayer(ctx, req.(*ModeratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mafia_RevivePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModeratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServer).RevivePlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.Mafia/RevivePlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServer).RevivePlayer(ctx, req.(*ModeratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mafia_KickPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModeratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServer).KickPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.Mafia/KickPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServer).KickPlayer(ctx, req.(*ModeratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Mafia_ServiceDesc is the grpc.ServiceDesc for Mafia service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -217,6 +967,34 @@ var Mafia_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "mafia.Mafia",
 	HandlerType: (*MafiaServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Register",
+			Handler:    _Mafia_Register_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _Mafia_Login_Handler,
+		},
+		{
+			MethodName: "ListGames",
+			Handler:    _Mafia_ListGames_Handler,
+		},
+		{
+			MethodName: "GetGame",
+			Handler:    _Mafia_GetGame_Handler,
+		},
+		{
+			MethodName: "GetGameTimeline",
+			Handler:    _Mafia_GetGameTimeline_Handler,
+		},
+		{
+			MethodName: "GetPlayerStats",
+			Handler:    _Mafia_GetPlayerStats_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _Mafia_GetLeaderboard_Handler,
+		},
 		{
 			MethodName: "Vote",
 			Handler:    _Mafia_Vote_Handler,
@@ -229,6 +1007,50 @@ var Mafia_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSessionState",
 			Handler:    _Mafia_GetSessionState_Handler,
 		},
+		{
+			MethodName: "SetReady",
+			Handler:    _Mafia_SetReady_Handler,
+		},
+		{
+			MethodName: "StartGame",
+			Handler:    _Mafia_StartGame_Handler,
+		},
+		{
+			MethodName: "SendMessage",
+			Handler:    _Mafia_SendMessage_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _Mafia_ListSessions_Handler,
+		},
+		{
+			MethodName: "InviteModerator",
+			Handler:    _Mafia_InviteModerator_Handler,
+		},
+		{
+			MethodName: "PauseTimer",
+			Handler:    _Mafia_PauseTimer_Handler,
+		},
+		{
+			MethodName: "ResumeTimer",
+			Handler:    _Mafia_ResumeTimer_Handler,
+		},
+		{
+			MethodName: "AdvancePhase",
+			Handler:    _Mafia_AdvancePhase_Handler,
+		},
+		{
+			MethodName: "KillPlayer",
+			Handler:    _Mafia_KillPlayer_Handler,
+		},
+		{
+			MethodName: "RevivePlayer",
+			Handler:    _Mafia_RevivePlayer_Handler,
+		},
+		{
+			MethodName: "KickPlayer",
+			Handler:    _Mafia_KickPlayer_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -236,6 +1058,251 @@ var Mafia_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Mafia_StartSession_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Resume",
+			Handler:       _Mafia_Resume_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Spectate",
+			Handler:       _Mafia_Spectate_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Moderate",
+			Handler:       _Mafia_Moderate_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "mafia.proto",
+}
+
+// MafiaAdminClient is the client API for MafiaAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MafiaAdminClient interface {
+	ListSessions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SessionList, error)
+	GetSession(ctx context.Context, in *AdminSessionRequest, opts ...grpc.CallOption) (*AdminSessionInfo, error)
+	TerminateSession(ctx context.Context, in *AdminSessionRequest, opts ...grpc.CallOption) (*Empty, error)
+	KickPlayer(ctx context.Context, in *AdminKickRequest, opts ...grpc.CallOption) (*Empty, error)
+	GrantModerator(ctx context.Context, in *AdminModeratorRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type mafiaAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMafiaAdminClient(cc grpc.ClientConnInterface) MafiaAdminClient {
+	return &mafiaAdminClient{cc}
+}
+
+func (c *mafiaAdminClient) ListSessions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SessionList, error) {
+	out := new(SessionList)
+	err := c.cc.Invoke(ctx, "/mafia.MafiaAdmin/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaAdminClient) GetSession(ctx context.Context, in *AdminSessionRequest, opts ...grpc.CallOption) (*AdminSessionInfo, error) {
+	out := new(AdminSessionInfo)
+	err := c.cc.Invoke(ctx, "/mafia.MafiaAdmin/GetSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaAdminClient) TerminateSession(ctx context.Context, in *AdminSessionRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mafia.MafiaAdmin/TerminateSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaAdminClient) KickPlayer(ctx context.Context, in *AdminKickRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mafia.MafiaAdmin/KickPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaAdminClient) GrantModerator(ctx context.Context, in *AdminModeratorRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/mafia.MafiaAdmin/GrantModerator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MafiaAdminServer is the server API for MafiaAdmin service.
+// All implementations must embed UnimplementedMafiaAdminServer
+// for forward compatibility
+type MafiaAdminServer interface {
+	ListSessions(context.Context, *Empty) (*SessionList, error)
+	GetSession(context.Context, *AdminSessionRequest) (*AdminSessionInfo, error)
+	TerminateSession(context.Context, *AdminSessionRequest) (*Empty, error)
+	KickPlayer(context.Context, *AdminKickRequest) (*Empty, error)
+	GrantModerator(context.Context, *AdminModeratorRequest) (*Empty, error)
+	mustEmbedUnimplementedMafiaAdminServer()
+}
+
+// UnimplementedMafiaAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedMafiaAdminServer struct {
+}
+
+func (UnimplementedMafiaAdminServer) ListSessions(context.Context, *Empty) (*SessionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedMafiaAdminServer) GetSession(context.Context, *AdminSessionRequest) (*AdminSessionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSession not implemented")
+}
+func (UnimplementedMafiaAdminServer) TerminateSession(context.Context, *AdminSessionRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateSession not implemented")
+}
+func (UnimplementedMafiaAdminServer) KickPlayer(context.Context, *AdminKickRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickPlayer not implemented")
+}
+func (UnimplementedMafiaAdminServer) GrantModerator(context.Context, *AdminModeratorRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantModerator not implemented")
+}
+func (UnimplementedMafiaAdminServer) mustEmbedUnimplementedMafiaAdminServer() {}
+
+// UnsafeMafiaAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MafiaAdminServer will
+// result in compilation errors.
+type UnsafeMafiaAdminServer interface {
+	mustEmbedUnimplementedMafiaAdminServer()
+}
+
+func RegisterMafiaAdminServer(s grpc.ServiceRegistrar, srv MafiaAdminServer) {
+	s.RegisterService(&MafiaAdmin_ServiceDesc, srv)
+}
+
+func _MafiaAdmin_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaAdminServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.MafiaAdmin/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaAdminServer).ListSessions(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MafiaAdmin_GetSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaAdminServer).GetSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.MafiaAdmin/GetSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaAdminServer).GetSession(ctx, req.(*AdminSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MafiaAdmin_TerminateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaAdminServer).TerminateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.MafiaAdmin/TerminateSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaAdminServer).TerminateSession(ctx, req.(*AdminSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MafiaAdmin_KickPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminKickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaAdminServer).KickPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.MafiaAdmin/KickPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaAdminServer).KickPlayer(ctx, req.(*AdminKickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MafiaAdmin_GrantModerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminModeratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaAdminServer).GrantModerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mafia.MafiaAdmin/GrantModerator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaAdminServer).GrantModerator(ctx, req.(*AdminModeratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MafiaAdmin_ServiceDesc is the grpc.ServiceDesc for MafiaAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MafiaAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mafia.MafiaAdmin",
+	HandlerType: (*MafiaAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSessions",
+			Handler:    _MafiaAdmin_ListSessions_Handler,
+		},
+		{
+			MethodName: "GetSession",
+			Handler:    _MafiaAdmin_GetSession_Handler,
+		},
+		{
+			MethodName: "TerminateSession",
+			Handler:    _MafiaAdmin_TerminateSession_Handler,
+		},
+		{
+			MethodName: "KickPlayer",
+			Handler:    _MafiaAdmin_KickPlayer_Handler,
+		},
+		{
+			MethodName: "GrantModerator",
+			Handler:    _MafiaAdmin_GrantModerator_Handler,
+		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "mafia.proto",
 }
@@ -3,28 +3,22 @@ package pb
 import (
 	"fmt"
 
-	"github.com/google/uuid"
 	"google.golang.org/grpc/metadata"
 )
 
-const metadataKeySessionID = "session_id"
+const metadataKeyToken = "authorization"
 
-func WithSessionID(id uuid.UUID) metadata.MD {
+func WithToken(token string) metadata.MD {
 	return metadata.New(map[string]string{
-		metadataKeySessionID: id.String(),
+		metadataKeyToken: token,
 	})
 }
 
-func FetchSessionID(md metadata.MD) (uuid.UUID, error) {
-	id := md.Get(metadataKeySessionID)
-	if len(id) != 1 {
-		return uuid.UUID{}, fmt.Errorf("metadata has invalid number of session id keys: expected 1, found %d", len(id))
+func FetchToken(md metadata.MD) (string, error) {
+	token := md.Get(metadataKeyToken)
+	if len(token) != 1 {
+		return "", fmt.Errorf("metadata has invalid number of token keys: expected 1, found %d", len(token))
 	}
 
-	parsed, err := uuid.Parse(id[0])
-	if err != nil {
-		return uuid.UUID{}, err
-	}
-
-	return parsed, nil
+	return token[0], nil
 }
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"soa_hw_2/internal/auth"
+	"soa_hw_2/internal/pb"
+	"soa_hw_2/internal/store"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const MinPasswordLength = 6
+
+// usernames are typed as command arguments and shown in chat, so they are single words of safe characters
+var usernamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,32}$`)
+
+func (ms *MafiaServer) Register(ctx context.Context, req *pb.AccountRequest) (*pb.AccountResponse, error) {
+	if !usernamePattern.MatchString(req.Username) {
+		return nil, status.Errorf(codes.InvalidArgument, "username should contain 1 to 32 letters, digits, '_', '-' or '.'")
+	}
+	if len(req.Password) < MinPasswordLength {
+		return nil, fmt.Errorf("password should contain at least %d characters", MinPasswordLength)
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %s", err)
+	}
+
+	account := &store.Account{
+		ID:           uuid.New(),
+		Username:     req.Username,
+		PasswordHash: hash,
+		CreatedAt:    time.Now(),
+	}
+	err = ms.stores.Accounts.Create(account)
+	if errors.Is(err, store.ErrExists) {
+		return nil, status.Errorf(codes.AlreadyExists, "account %s already exists", req.Username)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create account: %s", err)
+	}
+
+	ms.logger.Info("account registered", "username", req.Username)
+	return ms.issueAccountToken(account)
+}
+
+func (ms *MafiaServer) Login(ctx context.Context, req *pb.AccountRequest) (*pb.AccountResponse, error) {
+	// unknown account and wrong password are not told apart, so accounts can't be enumerated
+	account, err := ms.stores.Accounts.GetByUsername(req.Username)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid username or password")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account: %s", err)
+	}
+
+	err = bcrypt.CompareHashAndPassword(account.PasswordHash, []byte(req.Password))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid username or password")
+	}
+
+	return ms.issueAccountToken(account)
+}
+
+func (ms *MafiaServer) issueAccountToken(account *store.Account) (*pb.AccountResponse, error) {
+	token, err := ms.signer.Issue(auth.Claims{Kind: auth.KindAccount, AccountID: account.ID})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AccountResponse{AccountId: account.ID.String(), Token: token}, nil
+}
+
+func (ms *MafiaServer) getAccount(ctx context.Context) (*store.Account, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok || claims.Kind != auth.KindAccount {
+		return nil, fmt.Errorf("account token is not provided")
+	}
+	account, err := ms.stores.Accounts.GetByID(claims.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account")
+	}
+	return account, nil
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"path/filepath"
+	"soa_hw_2/internal/auth"
+	"soa_hw_2/internal/pb"
+	"soa_hw_2/internal/store"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func accountServer(t *testing.T) *MafiaServer {
+	accounts, err := store.NewFileAccountStore(filepath.Join(t.TempDir(), "accounts.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer := auth.NewSigner([]byte("secret"), time.Hour)
+	return NewMafiaServer(DefaultGameSettings(), DefaultMatchSettings(), signer, Stores{Accounts: accounts}, testLogger)
+}
+
+func TestRegister(t *testing.T) {
+	tests := []struct {
+		name     string
+		username string
+		password string
+		expected codes.Code
+	}{
+		{"valid account", "alice", "secret", codes.OK},
+		{"letters, digits and punctuation", "Bob_the-2nd.", "secret", codes.OK},
+		{"longest username", strings.Repeat("a", 32), "secret", codes.OK},
+		{"taken username", "alice", "secret", codes.AlreadyExists},
+		{"empty username", "", "secret", codes.InvalidArgument},
+		{"too long username", strings.Repeat("a", 33), "secret", codes.InvalidArgument},
+		{"username with space", "alice smith", "secret", codes.InvalidArgument},
+		{"username with control character", "alice\n", "secret", codes.InvalidArgument},
+		{"username with non ASCII letters", "алиса", "secret", codes.InvalidArgument},
+		{"short password", "carol", "12345", codes.Unknown},
+	}
+
+	ms := accountServer(t)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := ms.Register(context.Background(), &pb.AccountRequest{Username: test.username, Password: test.password})
+			if code := status.Code(err); code != test.expected {
+				t.Fatalf("expected %s, got %v", test.expected, err)
+			}
+			if err == nil && resp.Token == "" {
+				t.Fatal("expected account token")
+			}
+		})
+	}
+}
+
+func TestLogin(t *testing.T) {
+	ms := accountServer(t)
+	_, err := ms.Register(context.Background(), &pb.AccountRequest{Username: "alice", Password: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		username string
+		password string
+		expected codes.Code
+	}{
+		{"valid password", "alice", "secret", codes.OK},
+		{"wrong password", "alice", "wrong", codes.Unauthenticated},
+		{"unknown account", "bob", "secret", codes.Unauthenticated},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := ms.Login(context.Background(), &pb.AccountRequest{Username: test.username, Password: test.password})
+			if code := status.Code(err); code != test.expected {
+				t.Fatalf("expected %s, got %v", test.expected, err)
+			}
+			if err != nil && status.Convert(err).Message() != "invalid username or password" {
+				t.Fatalf("expected the same error for unknown account and wrong password, got %v", err)
+			}
+			if err == nil && resp.Token == "" {
+				t.Fatal("expected account token")
+			}
+		})
+	}
+}
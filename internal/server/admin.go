@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"soa_hw_2/internal/pb"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+type AdminServer struct {
+	pb.UnimplementedMafiaAdminServer
+
+	ms *MafiaServer
+}
+
+func NewAdminServer(ms *MafiaServer) *AdminServer {
+	return &AdminServer{ms: ms}
+}
+
+func (as *AdminServer) ListSessions(ctx context.Context, req *pb.Empty) (*pb.SessionList, error) {
+	sessions := as.ms.GetPlayerSessions()
+
+	summaries := []*pb.SessionSummary{}
+	for _, session := range sessions {
+		summaries = append(summaries, session.GetSummary())
+	}
+	return &pb.SessionList{Sessions: summaries}, nil
+}
+
+func (as *AdminServer) GetSession(ctx context.Context, req *pb.AdminSessionRequest) (*pb.AdminSessionInfo, error) {
+	session, err := as.findSession(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	return session.GetAdminInfo(), nil
+}
+
+func (as *AdminServer) TerminateSession(ctx context.Context, req *pb.AdminSessionRequest) (*pb.Empty, error) {
+	session, err := as.findSession(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	err = session.Terminate()
+	return &pb.Empty{}, err
+}
+
+func (as *AdminServer) KickPlayer(ctx context.Context, req *pb.AdminKickRequest) (*pb.Empty, error) {
+	session, err := as.findSession(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	err = session.KickPlayer(req.Username)
+	return &pb.Empty{}, err
+}
+
+func (as *AdminServer) GrantModerator(ctx context.Context, req *pb.AdminModeratorRequest) (*pb.Empty, error) {
+	session, err := as.findSession(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	err = session.AllowModerator(req.Username)
+	return &pb.Empty{}, err
+}
+
+func (as *AdminServer) findSession(sessionID string) (*Session, error) {
+	id, err := uuid.Parse(sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session id is provided")
+	}
+	for _, session := range as.ms.GetPlayerSessions() {
+		if session.id == id {
+			return session, nil
+		}
+	}
+	return nil, fmt.Errorf("session %s is not found", id)
+}
+
+func (ms *MafiaServer) GetPlayerSessions() []*Session {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	sessions := []*Session{}
+	for _, session := range ms.sessions {
+		sessions = append(sessions, session)
+	}
+	return sessions
+}
+
+func (s *Session) GetSummary() *pb.SessionSummary {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	_, _, _, alive := s.GetCounts()
+	return &pb.SessionSummary{
+		SessionId:    s.id.String(),
+		IsStarted:    s.isStarted,
+		IsEnded:      s.isEnded,
+		Phase:        s.GetPhase(),
+		PlayersCount: int32(len(s.players)),
+		AliveCount:   int32(len(alive)),
+		Host:         s.host,
+		MaxPlayers:   int32(s.settings.MaxPlayers()),
+	}
+}
+
+func (s *Session) GetAdminInfo() *pb.AdminSessionInfo {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	votes := []*pb.AdminSessionInfo_Vote{}
+	for username, voted := range s.votes {
+		votes = append(votes, &pb.AdminSessionInfo_Vote{Username: username, Voted: voted})
+	}
+	sort.Slice(votes, func(i, j int) bool {
+		return votes[i].Username < votes[j].Username
+	})
+
+	return &pb.AdminSessionInfo{
+		SessionId:       s.id.String(),
+		IsStarted:       s.isStarted,
+		IsEnded:         s.isEnded,
+		Phase:           s.GetPhase(),
+		Number:          int32(s.state/2 + 1),
+		SecondsLeft:     int32(s.GetTimeLeft().Seconds()),
+		Paused:          s.isPaused,
+		Host:            s.host,
+		HasModerator:    s.moderator != nil,
+		SpectatorsCount: int32(len(s.spectators)),
+		WinnerTeam:      s.winnerTeam,
+		Players:         s.GetAllPlayers(),
+		Votes:           votes,
+		IsChecked:       s.isChecked,
+	}
+}
+
+func (s *Session) Terminate() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.isEnded {
+		return fmt.Errorf("session is ended")
+	}
+
+	s.Log().Info("session is terminated")
+
+	s.Finish(pb.Team_UNKNOWN_TEAM)
+	return nil
+}
+
+func (s *Session) CloseUnlocked() {
+	for _, player := range s.players {
+		if player.ch != nil {
+			close(player.ch)
+			player.ch = nil
+		}
+	}
+	for id, ch := range s.spectators {
+		close(ch)
+		delete(s.spectators, id)
+	}
+	if s.moderator != nil {
+		close(s.moderator)
+		s.moderator = nil
+	}
+}
@@ -0,0 +1,274 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"soa_hw_2/internal/pb"
+	"soa_hw_2/internal/store"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func (s *Session) StartRecord() {
+	s.record = &store.GameRecord{
+		ID:        s.id,
+		StartedAt: time.Now(),
+		Phases:    []store.PhaseRecord{},
+		Events:    []store.EventRecord{},
+	}
+	s.ResetPhaseRecord()
+
+	event := pb.SessionEvent_SessionStartInfo{
+		Role:      pb.Role_UNKNOWN_ROLE,
+		Players:   s.GetAllPlayers(),
+		SessionId: s.id.String(),
+	}
+	s.RecordEvent(&pb.SessionEvent{EventInfo: &pb.SessionEvent_StartInfo{StartInfo: &event}})
+}
+
+func (s *Session) RecordEvent(event *pb.SessionEvent) {
+	if s.record == nil || s.isEnded {
+		return
+	}
+
+	data, err := protojson.Marshal(event)
+	if err != nil {
+		s.Log().Error("failed to record event", "error", err)
+		return
+	}
+	s.record.Events = append(s.record.Events, store.EventRecord{
+		Offset: time.Since(s.record.StartedAt),
+		Event:  data,
+	})
+}
+
+func (s *Session) ResetPhaseRecord() {
+	s.current = store.PhaseRecord{
+		Phase:  s.GetPhase(),
+		Number: s.state/2 + 1,
+	}
+}
+
+func (s *Session) RecordPhase(eliminated string) {
+	if s.record == nil {
+		return
+	}
+
+	votes := make(map[string]string, len(s.votes))
+	for username, voted := range s.votes {
+		votes[username] = voted
+	}
+	s.current.Votes = votes
+	s.current.Eliminated = eliminated
+	s.record.Phases = append(s.record.Phases, s.current)
+}
+
+func (s *Session) RecordCheck(username string, target *Player) {
+	s.current.Checks = append(s.current.Checks, store.CheckRecord{
+		Username:   username,
+		Target:     target.username,
+		TargetRole: target.role,
+	})
+}
+
+func (s *Session) RecordRemoved(username string) {
+	s.current.Removed = append(s.current.Removed, username)
+}
+
+func (s *Session) SaveRecord(winner pb.Team) {
+	if s.record == nil || s.stores.Games == nil {
+		return
+	}
+
+	if len(s.votes) > 0 || len(s.current.Checks) > 0 || len(s.current.Removed) > 0 {
+		s.RecordPhase("")
+	}
+
+	s.record.FinishedAt = time.Now()
+	s.record.Winner = winner
+	s.record.Players = []store.GamePlayer{}
+	for _, username := range s.lobby {
+		player := s.players[username]
+		s.record.Players = append(s.record.Players, store.GamePlayer{
+			Username: username,
+			Role:     player.role,
+			Survived: player.liveness,
+		})
+	}
+
+	err := s.stores.Games.Add(s.record)
+	if err != nil {
+		s.Log().Error("failed to save game", "error", err)
+	}
+
+	if s.stores.Ratings != nil {
+		err = UpdateRatings(s.stores.Ratings, s.record)
+		if err != nil {
+			s.Log().Error("failed to update ratings", "error", err)
+		}
+	}
+}
+
+func (ms *MafiaServer) ListGames(ctx context.Context, req *pb.Empty) (*pb.GameList, error) {
+	account, err := ms.getAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ms.stores.Games == nil {
+		return nil, status.Error(codes.Unimplemented, "game history is disabled")
+	}
+
+	records, err := ms.stores.Games.ListByPlayer(account.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	games := []*pb.GameSummary{}
+	for _, record := range records {
+		player, _ := record.GetPlayer(account.Username)
+		games = append(games, &pb.GameSummary{
+			GameId:       record.ID.String(),
+			StartedAt:    record.StartedAt.Unix(),
+			FinishedAt:   record.FinishedAt.Unix(),
+			Winner:       record.Winner,
+			Role:         player.Role,
+			Won:          RoleTeam(player.Role) == record.Winner,
+			Survived:     player.Survived,
+			PlayersCount: int32(len(record.Players)),
+		})
+	}
+	return &pb.GameList{Games: games}, nil
+}
+
+func (ms *MafiaServer) GetGame(ctx context.Context, req *pb.GameRequest) (*pb.GameInfo, error) {
+	account, err := ms.getAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ms.stores.Games == nil {
+		return nil, status.Error(codes.Unimplemented, "game history is disabled")
+	}
+
+	id, err := uuid.Parse(req.GameId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid game id is provided")
+	}
+	record, err := ms.stores.Games.Get(id)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "game %s is not found", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := record.GetPlayer(account.Username); !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "%s didn't play game %s", account.Username, id)
+	}
+
+	return GameRecordToInfo(record), nil
+}
+
+func GameRecordToInfo(record *store.GameRecord) *pb.GameInfo {
+	players := []*pb.Player{}
+	for _, player := range record.Players {
+		players = append(players, &pb.Player{
+			Role:     player.Role,
+			Username: player.Username,
+			Liveness: player.Survived,
+		})
+	}
+
+	phases := []*pb.GameInfo_PhaseRecord{}
+	for _, phase := range record.Phases {
+		votes := []*pb.GameInfo_Vote{}
+		for username, voted := range phase.Votes {
+			votes = append(votes, &pb.GameInfo_Vote{Username: username, Voted: voted})
+		}
+		sort.Slice(votes, func(i, j int) bool {
+			return votes[i].Username < votes[j].Username
+		})
+
+		checks := []*pb.GameInfo_Check{}
+		for _, check := range phase.Checks {
+			checks = append(checks, &pb.GameInfo_Check{
+				Username:   check.Username,
+				Target:     check.Target,
+				TargetRole: check.TargetRole,
+			})
+		}
+
+		phases = append(phases, &pb.GameInfo_PhaseRecord{
+			Phase:      phase.Phase,
+			Number:     int32(phase.Number),
+			Votes:      votes,
+			Eliminated: phase.Eliminated,
+			Checks:     checks,
+			Removed:    phase.Removed,
+		})
+	}
+
+	return &pb.GameInfo{
+		GameId:     record.ID.String(),
+		StartedAt:  record.StartedAt.Unix(),
+		FinishedAt: record.FinishedAt.Unix(),
+		Winner:     record.Winner,
+		Players:    players,
+		Phases:     phases,
+	}
+}
+
+func RoleTeam(role pb.Role) pb.Team {
+	if role == pb.Role_MAFIA_ROLE {
+		return pb.Team_MAFIA
+	}
+	return pb.Team_CIVILIANS
+}
+
+func (ms *MafiaServer) GetGameTimeline(ctx context.Context, req *pb.GameRequest) (*pb.GameTimeline, error) {
+	account, err := ms.getAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ms.stores.Games == nil {
+		return nil, status.Error(codes.Unimplemented, "game history is disabled")
+	}
+
+	id, err := uuid.Parse(req.GameId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid game id is provided")
+	}
+	record, err := ms.stores.Games.Get(id)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "game %s is not found", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := record.GetPlayer(account.Username); !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "%s didn't play game %s", account.Username, id)
+	}
+
+	return GameRecordToTimeline(record)
+}
+
+func GameRecordToTimeline(record *store.GameRecord) (*pb.GameTimeline, error) {
+	events := []*pb.GameTimeline_Event{}
+	for _, recorded := range record.Events {
+		event := &pb.SessionEvent{}
+		err := protojson.Unmarshal(recorded.Event, event)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode event of game %s: %s", record.ID, err)
+		}
+		events = append(events, &pb.GameTimeline_Event{
+			OffsetMs: recorded.Offset.Milliseconds(),
+			Event:    event,
+		})
+	}
+
+	return &pb.GameTimeline{GameId: record.ID.String(), Events: events}, nil
+}
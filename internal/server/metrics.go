@@ -0,0 +1,122 @@
+package server
+
+import (
+	"net/http"
+	"soa_hw_2/internal/pb"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	gamesFinished = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mafia_games_finished_total",
+		Help: "Number of finished games by winning team.",
+	}, []string{"winner"})
+
+	rpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mafia_rpc_requests_total",
+		Help: "Number of game action RPCs.",
+	}, []string{"method"})
+
+	rpcErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mafia_rpc_errors_total",
+		Help: "Number of game action RPCs that failed.",
+	}, []string{"method"})
+
+	phaseDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "mafia_phase_duration_seconds",
+		Help:    "Duration of resolved game phases.",
+		Buckets: []float64{5, 15, 30, 60, 120, 180, 300, 600},
+	}, []string{"phase"})
+
+	gameDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "mafia_game_duration_seconds",
+		Help:    "Duration of finished games.",
+		Buckets: []float64{60, 180, 300, 600, 900, 1200, 1800, 3600},
+	})
+)
+
+func (ms *MafiaServer) MetricsHandler() http.Handler {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		gamesFinished,
+		rpcRequests,
+		rpcErrors,
+		phaseDuration,
+		gameDuration,
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "mafia_active_sessions",
+			Help: "Number of lobbies and games that are not finished.",
+		}, func() float64 {
+			sessions, _ := ms.countActivePlayers()
+			return float64(sessions)
+		}),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "mafia_active_players",
+			Help: "Number of connected living players in lobbies and games that are not finished.",
+		}, func() float64 {
+			_, players := ms.countActivePlayers()
+			return float64(players)
+		}),
+	)
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+func (ms *MafiaServer) countActivePlayers() (int, int) {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	sessions, players := 0, 0
+	for _, session := range ms.sessions {
+		count, ok := session.GetActivePlayers()
+		if ok {
+			sessions++
+			players += count
+		}
+	}
+	return sessions, players
+}
+
+func (s *Session) GetActivePlayers() (int, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.isEnded {
+		return 0, false
+	}
+	count := 0
+	for _, player := range s.players {
+		if player.liveness && !player.kicked && player.ch != nil {
+			count++
+		}
+	}
+	return count, true
+}
+
+func observeRPC(method string, err error) {
+	rpcRequests.WithLabelValues(method).Inc()
+	if err != nil {
+		rpcErrors.WithLabelValues(method).Inc()
+	}
+}
+
+func (s *Session) ObservePhase() {
+	if !s.phaseStartedAt.IsZero() {
+		phaseDuration.WithLabelValues(s.GetPhase().String()).Observe(time.Since(s.phaseStartedAt).Seconds())
+	}
+	s.phaseStartedAt = time.Now()
+}
+
+func (s *Session) ObserveFinish(winner pb.Team) {
+	if !s.isStarted || s.isEnded {
+		return
+	}
+	gamesFinished.WithLabelValues(winner.String()).Inc()
+	if s.record != nil {
+		gameDuration.Observe(time.Since(s.record.StartedAt).Seconds())
+	}
+}
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"soa_hw_2/internal/pb"
+	"testing"
+)
+
+func TestGetActivePlayers(t *testing.T) {
+	tests := []struct {
+		name     string
+		session  func(t *testing.T) *Session
+		expected int
+		active   bool
+	}{
+		{"lobby", func(t *testing.T) *Session {
+			return lobbySession(t, 3)
+		}, 3, true},
+		{"started game", func(t *testing.T) *Session {
+			s, _ := startedSession(t, DefaultGameSettings(), Stores{})
+			return s
+		}, 4, true},
+		{"dead player", func(t *testing.T) *Session {
+			s, _ := withDeadCivilian(t, false)
+			return s
+		}, 3, true},
+		{"kicked player", func(t *testing.T) *Session {
+			s, roles := startedSession(t, DefaultGameSettings(), Stores{})
+			err := s.KickPlayer(roles[pb.Role_CIVILIAN][0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			return s
+		}, 3, true},
+		{"disconnected player", func(t *testing.T) *Session {
+			s, roles := startedSession(t, DefaultGameSettings(), Stores{})
+			s.RemovePlayer(roles[pb.Role_CIVILIAN][0])
+			return s
+		}, 3, true},
+		{"restored game one player resumed", func(t *testing.T) *Session {
+			s, roles := startedSession(t, DefaultGameSettings(), Stores{})
+			s.mutex.Lock()
+			defer s.mutex.Unlock()
+			restored := NewSessionFromSnapshot(s.Snapshot(), DefaultGameSettings(), Stores{}, testLogger)
+			t.Cleanup(func() {
+				restored.mutex.Lock()
+				restored.StopTimer()
+				restored.mutex.Unlock()
+			})
+			err := restored.ResumePlayer(roles[pb.Role_MAFIA_ROLE][0], make(chan *pb.SessionEvent, PlayerEventsBuffer))
+			if err != nil {
+				t.Fatal(err)
+			}
+			return restored
+		}, 1, true},
+		{"finished game", func(t *testing.T) *Session {
+			s, roles := startedSession(t, DefaultGameSettings(), Stores{})
+			err := s.KickPlayer(roles[pb.Role_MAFIA_ROLE][0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			return s
+		}, 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			count, active := test.session(t).GetActivePlayers()
+			if count != test.expected || active != test.active {
+				t.Fatalf("expected %d players in active %t session, got %d in active %t", test.expected, test.active, count, active)
+			}
+		})
+	}
+}
@@ -0,0 +1,428 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"soa_hw_2/internal/pb"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"soa_hw_2/internal/auth"
+)
+
+func (s *Session) SetModerator(username string, ch chan *pb.SessionEvent) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.isEnded {
+		return fmt.Errorf("session is ended")
+	}
+	err := s.ValidateModerator(username)
+	if err != nil {
+		return err
+	}
+	if s.moderator != nil {
+		return fmt.Errorf("session already has moderator")
+	}
+
+	s.moderator = ch
+	s.Log().Info("moderator joined", "moderator", username)
+
+	if !s.isStarted {
+		s.SendLobbyUpdate()
+		return nil
+	}
+	s.SendModeratorStart()
+	SendNonBlocking(ch, s.GetPhaseEvent())
+	return nil
+}
+
+// ValidateModerator checks that the account was invited by host or granted by admin to moderate
+// the session and doesn't play in it, since moderator sees roles of all players.
+func (s *Session) ValidateModerator(username string) error {
+	if !s.moderators[username] {
+		return status.Errorf(codes.PermissionDenied, "%s is not allowed to moderate session %s", username, s.id)
+	}
+	_, ok := s.players[username]
+	if ok {
+		return status.Errorf(codes.PermissionDenied, "player %s can't moderate own session", username)
+	}
+	return nil
+}
+
+func (s *Session) AllowModerator(username string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.AllowModeratorUnlocked(username)
+}
+
+func (s *Session) InviteModerator(host string, username string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.host != host {
+		return fmt.Errorf("only host can invite moderator")
+	}
+	return s.AllowModeratorUnlocked(username)
+}
+
+func (s *Session) AllowModeratorUnlocked(username string) error {
+	if s.isEnded {
+		return fmt.Errorf("session is ended")
+	}
+	if username == "" {
+		return fmt.Errorf("username is empty")
+	}
+	_, ok := s.players[username]
+	if ok {
+		return fmt.Errorf("player %s can't moderate own session", username)
+	}
+
+	s.moderators[username] = true
+	s.Log().Info("moderator allowed", "moderator", username)
+	s.Persist()
+	return nil
+}
+
+func (s *Session) IsModerationAllowed(username string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return !s.isEnded && s.moderator == nil && s.ValidateModerator(username) == nil
+}
+
+func (s *Session) RemoveModerator() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.moderator = nil
+}
+
+func (s *Session) SendModeratorStart() {
+	event := pb.SessionEvent_SessionStartInfo{
+		Role:      pb.Role_UNKNOWN_ROLE,
+		Players:   s.GetAllPlayers(),
+		SessionId: s.id.String(),
+	}
+	info := pb.SessionEvent_StartInfo{StartInfo: &event}
+	SendNonBlocking(s.moderator, &pb.SessionEvent{EventInfo: &info})
+}
+
+func (s *Session) SendModeratorAction(action pb.ModeratorAction, username string) {
+	event := pb.SessionEvent_ModeratorActionInfo{Action: action, Username: username}
+	info := pb.SessionEvent_ModeratorActionInfo_{ModeratorActionInfo: &event}
+	s.SendEvent(&pb.SessionEvent{EventInfo: &info})
+}
+
+func (s *Session) PauseTimer() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	err := s.ValidateState()
+	if err != nil {
+		return err
+	}
+	if s.timer == nil || s.isPaused {
+		return fmt.Errorf("timer is not running")
+	}
+
+	s.remaining = time.Until(s.deadline)
+	s.timerEpoch++
+	s.timer.Stop()
+	s.isPaused = true
+
+	s.SendModeratorAction(pb.ModeratorAction_PAUSE_TIMER, "")
+	s.SendPhaseUpdate()
+	s.Persist()
+	return nil
+}
+
+func (s *Session) ResumeTimer() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	err := s.ValidateState()
+	if err != nil {
+		return err
+	}
+	if !s.isPaused {
+		return fmt.Errorf("timer is not paused")
+	}
+
+	s.ArmTimer(s.remaining)
+
+	s.SendModeratorAction(pb.ModeratorAction_RESUME_TIMER, "")
+	s.SendPhaseUpdate()
+	s.Persist()
+	return nil
+}
+
+func (s *Session) AdvancePhase() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	err := s.ValidateState()
+	if err != nil {
+		return err
+	}
+
+	s.SendModeratorAction(pb.ModeratorAction_ADVANCE_PHASE, "")
+	s.ResolvePhase(context.Background())
+	return nil
+}
+
+func (s *Session) KillPlayer(username string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	err := s.ValidateState()
+	if err != nil {
+		return err
+	}
+
+	player, ok := s.players[username]
+	if !ok || !player.liveness {
+		return fmt.Errorf("invalid player")
+	}
+
+	player.liveness = false
+	delete(s.votes, username)
+	s.RecordRemoved(username)
+
+	s.SendModeratorAction(pb.ModeratorAction_KILL_PLAYER, username)
+	s.CheckFinish()
+	s.UpdateState(context.Background())
+	s.Persist()
+	return nil
+}
+
+func (s *Session) RevivePlayer(username string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	err := s.ValidateState()
+	if err != nil {
+		return err
+	}
+
+	player, ok := s.players[username]
+	if !ok || player.liveness {
+		return fmt.Errorf("invalid player")
+	}
+	if player.kicked {
+		return fmt.Errorf("player %s is kicked and can't be revived", username)
+	}
+	if player.ch == nil {
+		return fmt.Errorf("player %s is disconnected and can't be revived", username)
+	}
+
+	player.liveness = true
+
+	s.SendModeratorAction(pb.ModeratorAction_REVIVE_PLAYER, username)
+	s.Persist()
+	return nil
+}
+
+func (s *Session) KickPlayer(username string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.isEnded {
+		return fmt.Errorf("session is ended")
+	}
+
+	player, ok := s.players[username]
+	if !ok {
+		return fmt.Errorf("invalid player")
+	}
+
+	s.Log().Info("player kicked", "player", username)
+
+	if player.ch != nil {
+		close(player.ch)
+		player.ch = nil
+	}
+
+	if !s.isStarted {
+		s.RemoveFromLobby(username)
+		return nil
+	}
+
+	player.liveness = false
+	player.kicked = true
+	delete(s.votes, username)
+	s.RecordRemoved(username)
+
+	s.SendModeratorAction(pb.ModeratorAction_KICK_PLAYER, username)
+	s.CheckFinish()
+	s.UpdateState(context.Background())
+	s.Persist()
+	return nil
+}
+
+func (ms *MafiaServer) Moderate(req *pb.ModerateRequest, s pb.Mafia_ModerateServer) error {
+	account, err := ms.getAccount(s.Context())
+	if err != nil {
+		return err
+	}
+
+	ms.mutex.Lock()
+	if ms.isShuttingDown {
+		ms.mutex.Unlock()
+		return status.Error(codes.Unavailable, "server is shutting down")
+	}
+
+	session, err := ms.findModerated(req.SessionId, account.Username)
+	if err != nil {
+		ms.mutex.Unlock()
+		return err
+	}
+
+	events := make(chan *pb.SessionEvent, 10)
+	err = session.SetModerator(account.Username, events)
+	if err != nil {
+		ms.mutex.Unlock()
+		return err
+	}
+
+	id := uuid.New()
+	ms.moderators[id] = &PlayerInfo{account.ID, account.Username, session}
+	ms.mutex.Unlock()
+
+	defer func() {
+		session.RemoveModerator()
+		ms.mutex.Lock()
+		delete(ms.moderators, id)
+		ms.removeAbandoned(session)
+		ms.mutex.Unlock()
+	}()
+
+	token, err := ms.signer.Issue(auth.Claims{
+		Kind:      auth.KindModerator,
+		AccountID: account.ID,
+		PlayerID:  id,
+		SessionID: session.id,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.SendHeader(pb.WithToken(token))
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			err := s.Send(event)
+			if err != nil {
+				return err
+			}
+		case <-s.Context().Done():
+			return nil
+		}
+	}
+}
+
+// findModerated returns the session by id, or the session the account is allowed to moderate
+// when id is empty.
+func (ms *MafiaServer) findModerated(sessionID string, username string) (*Session, error) {
+	if sessionID != "" {
+		id, err := uuid.Parse(sessionID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid session id is provided")
+		}
+		session, ok := ms.sessions[id]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "session %s is not found", id)
+		}
+		return session, nil
+	}
+
+	for _, session := range ms.sessions {
+		if session.IsModerationAllowed(username) {
+			return session, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "no session to moderate for %s", username)
+}
+
+func (ms *MafiaServer) InviteModerator(ctx context.Context, req *pb.ModeratorRequest) (*pb.Empty, error) {
+	playerInfo, err := ms.getPlayerInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = playerInfo.session.InviteModerator(playerInfo.username, req.Username)
+	return &pb.Empty{}, err
+}
+
+func (ms *MafiaServer) PauseTimer(ctx context.Context, req *pb.Empty) (*pb.Empty, error) {
+	session, err := ms.getModeratedSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = session.PauseTimer()
+	return &pb.Empty{}, err
+}
+
+func (ms *MafiaServer) ResumeTimer(ctx context.Context, req *pb.Empty) (*pb.Empty, error) {
+	session, err := ms.getModeratedSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = session.ResumeTimer()
+	return &pb.Empty{}, err
+}
+
+func (ms *MafiaServer) AdvancePhase(ctx context.Context, req *pb.Empty) (*pb.Empty, error) {
+	session, err := ms.getModeratedSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = session.AdvancePhase()
+	return &pb.Empty{}, err
+}
+
+func (ms *MafiaServer) KillPlayer(ctx context.Context, req *pb.ModeratorRequest) (*pb.Empty, error) {
+	session, err := ms.getModeratedSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = session.KillPlayer(req.Username)
+	return &pb.Empty{}, err
+}
+
+func (ms *MafiaServer) RevivePlayer(ctx context.Context, req *pb.ModeratorRequest) (*pb.Empty, error) {
+	session, err := ms.getModeratedSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = session.RevivePlayer(req.Username)
+	return &pb.Empty{}, err
+}
+
+func (ms *MafiaServer) KickPlayer(ctx context.Context, req *pb.ModeratorRequest) (*pb.Empty, error) {
+	session, err := ms.getModeratedSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = session.KickPlayer(req.Username)
+	return &pb.Empty{}, err
+}
+
+func (ms *MafiaServer) getModeratedSession(ctx context.Context) (*Session, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok || claims.Kind != auth.KindModerator {
+		return nil, fmt.Errorf("moderator token is not provided")
+	}
+	ms.mutex.Lock()
+	info, ok := ms.moderators[claims.PlayerID]
+	ms.mutex.Unlock()
+	if !ok || info.session.id != claims.SessionID || info.accountID != claims.AccountID {
+		return nil, fmt.Errorf("invalid moderator token is provided")
+	}
+
+	info.session.mutex.Lock()
+	defer info.session.mutex.Unlock()
+	err := info.session.ValidateModerator(info.username)
+	if err != nil {
+		return nil, err
+	}
+	return info.session, nil
+}
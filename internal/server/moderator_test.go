@@ -0,0 +1,247 @@
+package server
+
+import (
+	"context"
+	"soa_hw_2/internal/auth"
+	"soa_hw_2/internal/pb"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestModeratorCommands(t *testing.T) {
+	tests := []struct {
+		name          string
+		phaseDuration time.Duration
+		act           func(s *Session, roles map[pb.Role][]string) error
+		expected      string
+		check         func(t *testing.T, s *Session, roles map[pb.Role][]string)
+	}{
+		{"pause", DefaultPhaseDuration, func(s *Session, roles map[pb.Role][]string) error {
+			return s.PauseTimer()
+		}, "", func(t *testing.T, s *Session, roles map[pb.Role][]string) {
+			if !s.isPaused || s.remaining <= 0 || s.remaining > DefaultPhaseDuration {
+				t.Fatalf("expected paused timer with time left, got paused %t with %s left", s.isPaused, s.remaining)
+			}
+		}},
+		{"pause paused timer", DefaultPhaseDuration, func(s *Session, roles map[pb.Role][]string) error {
+			err := s.PauseTimer()
+			if err != nil {
+				return err
+			}
+			return s.PauseTimer()
+		}, "timer is not running", nil},
+		{"pause disabled timer", 0, func(s *Session, roles map[pb.Role][]string) error {
+			return s.PauseTimer()
+		}, "timer is not running", nil},
+		{"resume", DefaultPhaseDuration, func(s *Session, roles map[pb.Role][]string) error {
+			err := s.PauseTimer()
+			if err != nil {
+				return err
+			}
+			return s.ResumeTimer()
+		}, "", func(t *testing.T, s *Session, roles map[pb.Role][]string) {
+			if s.isPaused || s.GetTimeLeft() <= 0 || s.GetTimeLeft() > DefaultPhaseDuration {
+				t.Fatalf("expected running timer with time left, got paused %t with %s left", s.isPaused, s.GetTimeLeft())
+			}
+		}},
+		{"resume running timer", DefaultPhaseDuration, func(s *Session, roles map[pb.Role][]string) error {
+			return s.ResumeTimer()
+		}, "timer is not paused", nil},
+		{"advance", DefaultPhaseDuration, func(s *Session, roles map[pb.Role][]string) error {
+			return s.AdvancePhase()
+		}, "", func(t *testing.T, s *Session, roles map[pb.Role][]string) {
+			if s.GetPhase() != pb.Phase_DAY || s.isEnded {
+				t.Fatalf("expected day, got %s", s.GetPhase())
+			}
+		}},
+		{"kill", DefaultPhaseDuration, func(s *Session, roles map[pb.Role][]string) error {
+			return s.KillPlayer(roles[pb.Role_CIVILIAN][0])
+		}, "", func(t *testing.T, s *Session, roles map[pb.Role][]string) {
+			if s.players[roles[pb.Role_CIVILIAN][0]].liveness || s.isEnded {
+				t.Fatal("expected dead civilian in running game")
+			}
+		}},
+		{"kill dead player", DefaultPhaseDuration, func(s *Session, roles map[pb.Role][]string) error {
+			err := s.KillPlayer(roles[pb.Role_CIVILIAN][0])
+			if err != nil {
+				return err
+			}
+			return s.KillPlayer(roles[pb.Role_CIVILIAN][0])
+		}, "invalid player", nil},
+		{"kill finishes game for mafia", DefaultPhaseDuration, func(s *Session, roles map[pb.Role][]string) error {
+			err := s.KillPlayer(roles[pb.Role_CIVILIAN][0])
+			if err != nil {
+				return err
+			}
+			return s.KillPlayer(roles[pb.Role_CIVILIAN][1])
+		}, "", func(t *testing.T, s *Session, roles map[pb.Role][]string) {
+			if !s.isEnded || s.winnerTeam != pb.Team_MAFIA {
+				t.Fatalf("expected mafia won, got ended %t with winner %s", s.isEnded, s.winnerTeam)
+			}
+		}},
+		{"kill finishes game for civilians", DefaultPhaseDuration, func(s *Session, roles map[pb.Role][]string) error {
+			return s.KillPlayer(roles[pb.Role_MAFIA_ROLE][0])
+		}, "", func(t *testing.T, s *Session, roles map[pb.Role][]string) {
+			if !s.isEnded || s.winnerTeam != pb.Team_CIVILIANS {
+				t.Fatalf("expected civilians won, got ended %t with winner %s", s.isEnded, s.winnerTeam)
+			}
+		}},
+		{"revive", DefaultPhaseDuration, func(s *Session, roles map[pb.Role][]string) error {
+			err := s.KillPlayer(roles[pb.Role_CIVILIAN][0])
+			if err != nil {
+				return err
+			}
+			return s.RevivePlayer(roles[pb.Role_CIVILIAN][0])
+		}, "", func(t *testing.T, s *Session, roles map[pb.Role][]string) {
+			if !s.players[roles[pb.Role_CIVILIAN][0]].liveness {
+				t.Fatal("expected revived civilian")
+			}
+		}},
+		{"revive alive player", DefaultPhaseDuration, func(s *Session, roles map[pb.Role][]string) error {
+			return s.RevivePlayer(roles[pb.Role_CIVILIAN][0])
+		}, "invalid player", nil},
+		{"revive kicked player", DefaultPhaseDuration, func(s *Session, roles map[pb.Role][]string) error {
+			err := s.KickPlayer(roles[pb.Role_CIVILIAN][0])
+			if err != nil {
+				return err
+			}
+			return s.RevivePlayer(roles[pb.Role_CIVILIAN][0])
+		}, "is kicked and can't be revived", nil},
+		{"kick", DefaultPhaseDuration, func(s *Session, roles map[pb.Role][]string) error {
+			return s.KickPlayer(roles[pb.Role_CIVILIAN][0])
+		}, "", func(t *testing.T, s *Session, roles map[pb.Role][]string) {
+			player := s.players[roles[pb.Role_CIVILIAN][0]]
+			if player.liveness || !player.kicked || player.ch != nil || s.isEnded {
+				t.Fatal("expected kicked and disconnected civilian in running game")
+			}
+		}},
+		{"kick finishes game", DefaultPhaseDuration, func(s *Session, roles map[pb.Role][]string) error {
+			return s.KickPlayer(roles[pb.Role_MAFIA_ROLE][0])
+		}, "", func(t *testing.T, s *Session, roles map[pb.Role][]string) {
+			if !s.isEnded || s.winnerTeam != pb.Team_CIVILIANS {
+				t.Fatalf("expected civilians won, got ended %t with winner %s", s.isEnded, s.winnerTeam)
+			}
+		}},
+		{"kill after game is finished", DefaultPhaseDuration, func(s *Session, roles map[pb.Role][]string) error {
+			err := s.KickPlayer(roles[pb.Role_MAFIA_ROLE][0])
+			if err != nil {
+				return err
+			}
+			return s.KillPlayer(roles[pb.Role_CIVILIAN][0])
+		}, "session is ended", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			settings := DefaultGameSettings()
+			settings.PhaseDuration = test.phaseDuration
+			s, roles := startedSession(t, settings, Stores{})
+
+			err := test.act(s, roles)
+			if test.expected != "" {
+				if err == nil || !strings.Contains(err.Error(), test.expected) {
+					t.Fatalf("expected %q, got %v", test.expected, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			s.mutex.Lock()
+			defer s.mutex.Unlock()
+			test.check(t, s, roles)
+		})
+	}
+}
+
+func TestModeratorAuthorization(t *testing.T) {
+	ms := NewMafiaServer(DefaultGameSettings(), DefaultMatchSettings(), nil, Stores{}, testLogger)
+	s, roles := startedSession(t, DefaultGameSettings(), Stores{})
+	err := s.AllowModerator("moderator")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	accountID, id, strangerID := uuid.New(), uuid.New(), uuid.New()
+	ms.mutex.Lock()
+	ms.addSession(s)
+	ms.moderators[id] = &PlayerInfo{accountID: accountID, username: "moderator", session: s}
+	ms.moderators[strangerID] = &PlayerInfo{accountID: accountID, username: "stranger", session: s}
+	ms.mutex.Unlock()
+
+	moderator := auth.Claims{Kind: auth.KindModerator, AccountID: accountID, PlayerID: id, SessionID: s.id}
+	player := moderator
+	player.Kind = auth.KindPlayer
+	unknown := moderator
+	unknown.PlayerID = uuid.New()
+	otherAccount := moderator
+	otherAccount.AccountID = uuid.New()
+	otherSession := moderator
+	otherSession.SessionID = uuid.New()
+	stranger := moderator
+	stranger.PlayerID = strangerID
+
+	commands := map[string]func(ctx context.Context) error{
+		"pause": func(ctx context.Context) error {
+			_, err := ms.PauseTimer(ctx, &pb.Empty{})
+			return err
+		},
+		"resume": func(ctx context.Context) error {
+			_, err := ms.ResumeTimer(ctx, &pb.Empty{})
+			return err
+		},
+		"advance": func(ctx context.Context) error {
+			_, err := ms.AdvancePhase(ctx, &pb.Empty{})
+			return err
+		},
+		"kill": func(ctx context.Context) error {
+			_, err := ms.KillPlayer(ctx, &pb.ModeratorRequest{Username: roles[pb.Role_CIVILIAN][0]})
+			return err
+		},
+		"revive": func(ctx context.Context) error {
+			_, err := ms.RevivePlayer(ctx, &pb.ModeratorRequest{Username: roles[pb.Role_CIVILIAN][0]})
+			return err
+		},
+		"kick": func(ctx context.Context) error {
+			_, err := ms.KickPlayer(ctx, &pb.ModeratorRequest{Username: roles[pb.Role_CIVILIAN][0]})
+			return err
+		},
+	}
+
+	tests := []struct {
+		name     string
+		claims   *auth.Claims
+		expected string
+	}{
+		{"no token", nil, "moderator token is not provided"},
+		{"player token", &player, "moderator token is not provided"},
+		{"unknown moderator", &unknown, "invalid moderator token is provided"},
+		{"token of other account", &otherAccount, "invalid moderator token is provided"},
+		{"token of other session", &otherSession, "invalid moderator token is provided"},
+		{"not allowed to moderate", &stranger, "stranger is not allowed to moderate session"},
+	}
+
+	for _, test := range tests {
+		for name, command := range commands {
+			t.Run(test.name+" "+name, func(t *testing.T) {
+				ctx := context.Background()
+				if test.claims != nil {
+					ctx = auth.WithClaims(ctx, test.claims)
+				}
+				err := command(ctx)
+				if err == nil || !strings.Contains(err.Error(), test.expected) {
+					t.Fatalf("expected %q, got %v", test.expected, err)
+				}
+			})
+		}
+	}
+
+	_, err = ms.PauseTimer(auth.WithClaims(context.Background(), &moderator), &pb.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,205 @@
+package server
+
+import (
+	"fmt"
+	"log/slog"
+	"soa_hw_2/internal/pb"
+	"soa_hw_2/internal/store"
+	"sort"
+	"time"
+)
+
+func (s *Session) Snapshot() *store.SessionSnapshot {
+	players := []store.PlayerSnapshot{}
+	for _, username := range s.lobby {
+		player := s.players[username]
+		players = append(players, store.PlayerSnapshot{
+			Username: player.username,
+			Role:     player.role,
+			Liveness: player.liveness,
+			Kicked:   player.kicked,
+		})
+	}
+
+	moderators := []string{}
+	for username := range s.moderators {
+		moderators = append(moderators, username)
+	}
+	sort.Strings(moderators)
+
+	votes := make(map[string]string, len(s.votes))
+	for username, voted := range s.votes {
+		votes[username] = voted
+	}
+
+	snapshot := &store.SessionSnapshot{
+		ID:            s.id,
+		Host:          s.host,
+		Players:       players,
+		Moderators:    moderators,
+		State:         s.state,
+		Votes:         votes,
+		IsChecked:     s.isChecked,
+		PhaseDuration: s.phaseDuration,
+		TimeLeft:      s.GetTimeLeft(),
+		IsPaused:      s.isPaused && !s.resumeTimer,
+		Current:       s.current,
+		SavedAt:       time.Now(),
+	}
+	if s.record != nil {
+		snapshot.StartedAt = s.record.StartedAt
+		snapshot.Phases = s.record.Phases
+		snapshot.Events = s.record.Events
+	}
+	return snapshot
+}
+
+func (s *Session) Persist() {
+	if s.stores.Sessions == nil || !s.isStarted || s.isSuspended {
+		return
+	}
+
+	var err error
+	if s.isEnded {
+		err = s.stores.Sessions.Delete(s.id)
+	} else {
+		err = s.stores.Sessions.Save(s.Snapshot())
+	}
+	if err != nil {
+		s.Log().Error("failed to persist session", "error", err)
+	}
+}
+
+func (s *Session) Checkpoint() {
+	s.Persist()
+	s.isSuspended = true
+	s.StopTimer()
+	s.timerEpoch++
+	s.Log().Info("session is checkpointed")
+}
+
+func NewSessionFromSnapshot(snapshot *store.SessionSnapshot, settings GameSettings, stores Stores, logger *slog.Logger) *Session {
+	s := NewSession(settings, stores, logger)
+	s.id = snapshot.ID
+	s.logger = logger.With("session", s.id.String())
+	s.host = snapshot.Host
+	s.isStarted = true
+	s.state = snapshot.State
+	s.isChecked = snapshot.IsChecked
+	s.phaseDuration = snapshot.PhaseDuration
+	for username, voted := range snapshot.Votes {
+		s.votes[username] = voted
+	}
+	for _, username := range snapshot.Moderators {
+		s.moderators[username] = true
+	}
+	for _, player := range snapshot.Players {
+		s.players[player.Username] = &Player{
+			role:     player.Role,
+			username: player.Username,
+			liveness: player.Liveness,
+			kicked:   player.Kicked,
+			ready:    true,
+		}
+		s.lobby = append(s.lobby, player.Username)
+	}
+
+	s.record = &store.GameRecord{
+		ID:        snapshot.ID,
+		StartedAt: snapshot.StartedAt,
+		Phases:    append([]store.PhaseRecord{}, snapshot.Phases...),
+		Events:    append([]store.EventRecord{}, snapshot.Events...),
+	}
+	s.current = snapshot.Current
+
+	if s.phaseDuration != 0 {
+		s.isPaused = true
+		s.remaining = snapshot.TimeLeft
+		s.resumeTimer = !snapshot.IsPaused
+	}
+	return s
+}
+
+// RestoreSessions restores saved games, which are terminated if no player resumes them within timeout.
+func (ms *MafiaServer) RestoreSessions(timeout time.Duration) error {
+	if ms.stores.Sessions == nil {
+		return nil
+	}
+
+	snapshots, err := ms.stores.Sessions.List()
+	if err != nil {
+		return err
+	}
+
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+	for _, snapshot := range snapshots {
+		session := NewSessionFromSnapshot(snapshot, ms.settings, ms.stores, ms.logger)
+		ms.addSession(session)
+		session.Log().Info("session is restored", "saved_at", snapshot.SavedAt.Format(time.RFC3339))
+		if timeout > 0 {
+			time.AfterFunc(timeout, session.TerminateIfNotResumed)
+		}
+	}
+	return nil
+}
+
+func (s *Session) TerminateIfNotResumed() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.ValidateState() != nil {
+		return
+	}
+	for _, player := range s.players {
+		if player.ch != nil {
+			return
+		}
+	}
+
+	s.Log().Info("restored session is not resumed in time, terminating")
+	s.Finish(pb.Team_UNKNOWN_TEAM)
+}
+
+func (s *Session) CanResume(username string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	player, ok := s.players[username]
+	return ok && s.ValidateState() == nil && !player.kicked && player.ch == nil
+}
+
+func (s *Session) ResumePlayer(username string, ch chan *pb.SessionEvent) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	err := s.ValidateState()
+	if err != nil {
+		return err
+	}
+
+	player, ok := s.players[username]
+	if !ok || player.kicked {
+		return fmt.Errorf("player %s is not in session %s", username, s.id)
+	}
+	if player.ch != nil {
+		return fmt.Errorf("player %s is already connected", username)
+	}
+	player.ch = ch
+
+	s.Log().Info("player resumed game", "player", username)
+
+	state, _ := s.GetStateUnlocked(username)
+	event := pb.SessionEvent_SessionStartInfo{
+		Role:      state.Player.Role,
+		Players:   state.Players,
+		SessionId: s.id.String(),
+	}
+	info := pb.SessionEvent_StartInfo{StartInfo: &event}
+	ch <- &pb.SessionEvent{EventInfo: &info}
+
+	if s.resumeTimer {
+		s.ArmTimer(s.remaining)
+		s.SendPhaseUpdate()
+	} else {
+		ch <- s.GetPhaseEvent()
+	}
+	return nil
+}
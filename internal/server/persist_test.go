@@ -0,0 +1,248 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
+	"soa_hw_2/internal/pb"
+	"soa_hw_2/internal/store"
+	"testing"
+	"time"
+)
+
+var testLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// startedSession returns a started game of four players with usernames by role.
+func startedSession(t *testing.T, settings GameSettings, stores Stores) (*Session, map[pb.Role][]string) {
+	s := NewSession(settings, stores, testLogger)
+	for i := 0; i < settings.MaxPlayers(); i++ {
+		err := s.AddPlayer(fmt.Sprintf("player%d", i), store.DefaultRating, make(chan *pb.SessionEvent, PlayerEventsBuffer))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, username := range append([]string{}, s.lobby...) {
+		err := s.SetReady(username, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Cleanup(func() {
+		s.mutex.Lock()
+		s.StopTimer()
+		s.mutex.Unlock()
+	})
+
+	roles := make(map[pb.Role][]string)
+	for _, username := range s.lobby {
+		role := s.players[username].role
+		roles[role] = append(roles[role], username)
+	}
+	return s, roles
+}
+
+type playerState struct {
+	role     pb.Role
+	liveness bool
+	kicked   bool
+}
+
+func playerStates(s *Session) map[string]playerState {
+	states := make(map[string]playerState)
+	for username, player := range s.players {
+		states[username] = playerState{player.role, player.liveness, player.kicked}
+	}
+	return states
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	tests := []struct {
+		name          string
+		phaseDuration time.Duration
+		act           func(t *testing.T, s *Session, roles map[pb.Role][]string)
+	}{
+		{"first night", DefaultPhaseDuration, func(t *testing.T, s *Session, roles map[pb.Role][]string) {}},
+		{"mafia voted", DefaultPhaseDuration, func(t *testing.T, s *Session, roles map[pb.Role][]string) {
+			err := s.Vote(context.Background(), roles[pb.Role_MAFIA_ROLE][0], roles[pb.Role_CIVILIAN][0])
+			if err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{"day after night", DefaultPhaseDuration, func(t *testing.T, s *Session, roles map[pb.Role][]string) {
+			err := s.Vote(context.Background(), roles[pb.Role_MAFIA_ROLE][0], roles[pb.Role_CIVILIAN][0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = s.Check(context.Background(), roles[pb.Role_SHERIFF][0], roles[pb.Role_CIVILIAN][1])
+			if err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{"timer paused by moderator", DefaultPhaseDuration, func(t *testing.T, s *Session, roles map[pb.Role][]string) {
+			err := s.PauseTimer()
+			if err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{"player kicked", DefaultPhaseDuration, func(t *testing.T, s *Session, roles map[pb.Role][]string) {
+			err := s.KickPlayer(roles[pb.Role_CIVILIAN][0])
+			if err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{"timer disabled", 0, func(t *testing.T, s *Session, roles map[pb.Role][]string) {}},
+		{"moderator granted", DefaultPhaseDuration, func(t *testing.T, s *Session, roles map[pb.Role][]string) {
+			err := s.AllowModerator("moderator")
+			if err != nil {
+				t.Fatal(err)
+			}
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sessions, err := store.NewFileSessionStore(t.TempDir())
+			if err != nil {
+				t.Fatal(err)
+			}
+			settings := DefaultGameSettings()
+			settings.PhaseDuration = test.phaseDuration
+			s, roles := startedSession(t, settings, Stores{Sessions: sessions})
+			test.act(t, s, roles)
+
+			snapshots, err := sessions.List()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(snapshots) != 1 {
+				t.Fatalf("expected 1 saved session, got %d", len(snapshots))
+			}
+			restored := NewSessionFromSnapshot(snapshots[0], settings, Stores{}, testLogger)
+
+			s.mutex.Lock()
+			defer s.mutex.Unlock()
+			if restored.id != s.id || restored.host != s.host || restored.state != s.state || restored.isChecked != s.isChecked {
+				t.Fatalf("expected session %s of %s in state %d, got %s of %s in state %d",
+					s.id, s.host, s.state, restored.id, restored.host, restored.state)
+			}
+			if !reflect.DeepEqual(restored.votes, s.votes) {
+				t.Fatalf("expected votes %v, got %v", s.votes, restored.votes)
+			}
+			if !reflect.DeepEqual(playerStates(restored), playerStates(s)) {
+				t.Fatalf("expected players %v, got %v", playerStates(s), playerStates(restored))
+			}
+			if !reflect.DeepEqual(restored.moderators, s.moderators) {
+				t.Fatalf("expected moderators %v, got %v", s.moderators, restored.moderators)
+			}
+			if !reflect.DeepEqual(restored.lobby, s.lobby) {
+				t.Fatalf("expected lobby order %v, got %v", s.lobby, restored.lobby)
+			}
+
+			// restored timer waits for the first player, unless it was paused by moderator
+			if test.phaseDuration != 0 && (!restored.isPaused || restored.resumeTimer == s.isPaused) {
+				t.Fatalf("expected paused timer resuming %t, got paused %t resuming %t", !s.isPaused, restored.isPaused, restored.resumeTimer)
+			}
+			if test.phaseDuration == 0 && restored.isPaused {
+				t.Fatal("expected no timer")
+			}
+
+			for username, player := range s.players {
+				if restored.CanResume(username) == player.kicked {
+					t.Fatalf("expected %s can resume: %t", username, !player.kicked)
+				}
+			}
+		})
+	}
+}
+
+func TestResumePlayer(t *testing.T) {
+	s, roles := startedSession(t, DefaultGameSettings(), Stores{})
+	s.mutex.Lock()
+	snapshot := s.Snapshot()
+	s.mutex.Unlock()
+
+	restored := NewSessionFromSnapshot(snapshot, DefaultGameSettings(), Stores{}, testLogger)
+	t.Cleanup(func() {
+		restored.mutex.Lock()
+		restored.StopTimer()
+		restored.mutex.Unlock()
+	})
+
+	mafia := roles[pb.Role_MAFIA_ROLE][0]
+	events := make(chan *pb.SessionEvent, PlayerEventsBuffer)
+	err := restored.ResumePlayer(mafia, events)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = restored.ResumePlayer(mafia, make(chan *pb.SessionEvent, PlayerEventsBuffer))
+	if err == nil {
+		t.Fatal("expected error on resuming connected player")
+	}
+	err = restored.ResumePlayer("stranger", make(chan *pb.SessionEvent, PlayerEventsBuffer))
+	if err == nil {
+		t.Fatal("expected error on resuming unknown player")
+	}
+
+	start := (<-events).GetStartInfo()
+	if start == nil || start.Role != pb.Role_MAFIA_ROLE || start.SessionId != s.id.String() {
+		t.Fatalf("expected start info of mafia, got %v", start)
+	}
+	phase := (<-events).GetPhaseInfo()
+	if phase == nil || phase.Phase != pb.Phase_NIGHT || phase.Paused {
+		t.Fatalf("expected running night, got %v", phase)
+	}
+}
+
+func TestTerminateIfNotResumed(t *testing.T) {
+	tests := []struct {
+		name    string
+		resumed bool
+		ended   bool
+	}{
+		{"nobody resumed", false, true},
+		{"player resumed", true, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, roles := startedSession(t, DefaultGameSettings(), Stores{})
+			s.mutex.Lock()
+			snapshot := s.Snapshot()
+			s.mutex.Unlock()
+
+			games, err := store.NewFileGameStore(t.TempDir())
+			if err != nil {
+				t.Fatal(err)
+			}
+			restored := NewSessionFromSnapshot(snapshot, DefaultGameSettings(), Stores{Games: games}, testLogger)
+			t.Cleanup(func() {
+				restored.mutex.Lock()
+				restored.StopTimer()
+				restored.mutex.Unlock()
+			})
+			if test.resumed {
+				err = restored.ResumePlayer(roles[pb.Role_CIVILIAN][0], make(chan *pb.SessionEvent, PlayerEventsBuffer))
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			restored.TerminateIfNotResumed()
+			if restored.isEnded != test.ended {
+				t.Fatalf("expected ended %t, got %t", test.ended, restored.isEnded)
+			}
+			records, err := games.List()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.ended && (len(records) != 1 || records[0].Winner != pb.Team_UNKNOWN_TEAM) {
+				t.Fatalf("expected terminated game in history, got %v", records)
+			}
+			if !test.ended && len(records) != 0 {
+				t.Fatalf("expected no finished games, got %d", len(records))
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"math"
+	"soa_hw_2/internal/pb"
+	"soa_hw_2/internal/store"
+	"time"
+)
+
+const RatingK = 32.0
+
+type MatchSettings struct {
+	Window       float64
+	WindowGrowth float64
+}
+
+func DefaultMatchSettings() MatchSettings {
+	return MatchSettings{
+		Window:       100,
+		WindowGrowth: 5,
+	}
+}
+
+func (m MatchSettings) WindowAfter(wait time.Duration) float64 {
+	return m.Window + m.WindowGrowth*wait.Seconds()
+}
+
+func UpdateRatings(ratings store.RatingStore, record *store.GameRecord) error {
+	if record.Winner == pb.Team_UNKNOWN_TEAM {
+		return nil
+	}
+
+	current := []*store.Rating{}
+	sums := make(map[pb.Team]float64)
+	counts := make(map[pb.Team]int)
+	for _, player := range record.Players {
+		rating, err := ratings.Get(player.Username)
+		if err != nil {
+			return err
+		}
+		current = append(current, rating)
+		team := RoleTeam(player.Role)
+		sums[team] += rating.Rating
+		counts[team]++
+	}
+
+	average := func(team pb.Team) float64 {
+		if counts[team] == 0 {
+			return store.DefaultRating
+		}
+		return sums[team] / float64(counts[team])
+	}
+
+	for i, player := range record.Players {
+		team := RoleTeam(player.Role)
+		opponent := pb.Team_MAFIA
+		if team == pb.Team_MAFIA {
+			opponent = pb.Team_CIVILIANS
+		}
+
+		expected := 1 / (1 + math.Pow(10, (average(opponent)-average(team))/400))
+		score := 0.0
+		if team == record.Winner {
+			score = 1
+		}
+		current[i].Rating += RatingK * (score - expected)
+		current[i].Games++
+	}
+	return ratings.Update(current)
+}
+
+func (ms *MafiaServer) getRating(username string) float64 {
+	if ms.stores.Ratings == nil {
+		return store.DefaultRating
+	}
+	rating, err := ms.stores.Ratings.Get(username)
+	if err != nil {
+		return store.DefaultRating
+	}
+	return rating.Rating
+}
+
+func (ms *MafiaServer) findLobby(rating float64) *Session {
+	var best, empty *Session
+	bestDistance := math.Inf(1)
+	for _, session := range ms.sessions {
+		lobbyRating, wait, count, ok := session.GetLobbyRating()
+		if !ok {
+			continue
+		}
+		if count == 0 {
+			empty = session
+			continue
+		}
+		distance := math.Abs(lobbyRating - rating)
+		if distance <= ms.match.WindowAfter(wait) && distance < bestDistance {
+			best, bestDistance = session, distance
+		}
+	}
+
+	if best == nil {
+		return empty
+	}
+	return best
+}
+
+func (s *Session) GetLobbyRating() (float64, time.Duration, int, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.isStarted || s.isEnded || len(s.players) >= s.settings.MaxPlayers() {
+		return 0, 0, 0, false
+	}
+	if len(s.players) == 0 {
+		return 0, 0, 0, true
+	}
+
+	// window grows with wait of the longest waiting player in the lobby, so it starts over when lobby empties
+	sum := 0.0
+	var joinedAt time.Time
+	for _, player := range s.players {
+		sum += player.rating
+		if joinedAt.IsZero() || player.joinedAt.Before(joinedAt) {
+			joinedAt = player.joinedAt
+		}
+	}
+	return sum / float64(len(s.players)), time.Since(joinedAt), len(s.players), true
+}
@@ -0,0 +1,164 @@
+package server
+
+import (
+	"fmt"
+	"math"
+	"path/filepath"
+	"soa_hw_2/internal/pb"
+	"soa_hw_2/internal/store"
+	"testing"
+	"time"
+)
+
+func TestUpdateRatings(t *testing.T) {
+	players := []store.GamePlayer{
+		{Username: "mafia", Role: pb.Role_MAFIA_ROLE},
+		{Username: "sheriff", Role: pb.Role_SHERIFF},
+		{Username: "civilian1", Role: pb.Role_CIVILIAN},
+		{Username: "civilian2", Role: pb.Role_CIVILIAN},
+	}
+
+	tests := []struct {
+		name     string
+		winner   pb.Team
+		initial  map[string]float64
+		expected map[string]float64
+	}{
+		{"mafia won equal teams", pb.Team_MAFIA, nil,
+			map[string]float64{"mafia": 1016, "sheriff": 984, "civilian1": 984, "civilian2": 984}},
+		{"civilians won equal teams", pb.Team_CIVILIANS, nil,
+			map[string]float64{"mafia": 984, "sheriff": 1016, "civilian1": 1016, "civilian2": 1016}},
+		{"favourite won", pb.Team_MAFIA, map[string]float64{"mafia": 1200},
+			map[string]float64{"mafia": 1207.69, "sheriff": 992.31, "civilian1": 992.31, "civilian2": 992.31}},
+		{"underdog won", pb.Team_CIVILIANS, map[string]float64{"mafia": 1200},
+			map[string]float64{"mafia": 1175.69, "sheriff": 1024.31, "civilian1": 1024.31, "civilian2": 1024.31}},
+		{"team average is used", pb.Team_CIVILIANS, map[string]float64{"sheriff": 1400, "civilian1": 800, "civilian2": 800},
+			map[string]float64{"mafia": 984, "sheriff": 1416, "civilian1": 816, "civilian2": 816}},
+		{"terminated game", pb.Team_UNKNOWN_TEAM, map[string]float64{"mafia": 1200},
+			map[string]float64{"mafia": 1200, "sheriff": 1000, "civilian1": 1000, "civilian2": 1000}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ratings, err := store.NewFileRatingStore(filepath.Join(t.TempDir(), "ratings.json"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			initial := []*store.Rating{}
+			for username, rating := range test.initial {
+				initial = append(initial, &store.Rating{Username: username, Rating: rating})
+			}
+			err = ratings.Update(initial)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = UpdateRatings(ratings, &store.GameRecord{Winner: test.winner, Players: players})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for username, expected := range test.expected {
+				rating, err := ratings.Get(username)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if math.Abs(rating.Rating-expected) > 0.01 {
+					t.Fatalf("expected %s rating %.2f, got %.2f", username, expected, rating.Rating)
+				}
+				games := 1
+				if test.winner == pb.Team_UNKNOWN_TEAM {
+					games = 0
+				}
+				if rating.Games != games {
+					t.Fatalf("expected %s played %d games, got %d", username, games, rating.Games)
+				}
+			}
+		})
+	}
+}
+
+func TestWindowAfter(t *testing.T) {
+	tests := []struct {
+		name     string
+		match    MatchSettings
+		wait     time.Duration
+		expected float64
+	}{
+		{"no wait", DefaultMatchSettings(), 0, 100},
+		{"grows with wait", DefaultMatchSettings(), 10 * time.Second, 150},
+		{"grows with fraction of second", DefaultMatchSettings(), 500 * time.Millisecond, 102.5},
+		{"no growth", MatchSettings{Window: 100}, time.Hour, 100},
+		{"matches anyone", MatchSettings{Window: math.Inf(1)}, 0, math.Inf(1)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			window := test.match.WindowAfter(test.wait)
+			if window != test.expected {
+				t.Fatalf("expected %f, got %f", test.expected, window)
+			}
+		})
+	}
+}
+
+type testLobby struct {
+	ratings []float64
+	wait    time.Duration
+	started bool
+}
+
+func TestFindLobby(t *testing.T) {
+	tests := []struct {
+		name     string
+		rating   float64
+		lobbies  []testLobby
+		expected int
+	}{
+		{"no lobbies", 1000, nil, -1},
+		{"empty lobby", 1000, []testLobby{{}}, 0},
+		{"lobby within window", 1000, []testLobby{{}, {ratings: []float64{1050}}}, 1},
+		{"lobby out of window", 1000, []testLobby{{}, {ratings: []float64{1200}}}, 0},
+		{"no lobby within window", 1000, []testLobby{{ratings: []float64{1200}}}, -1},
+		{"window grew while waiting", 1000, []testLobby{{}, {ratings: []float64{1200}, wait: 30 * time.Second}}, 1},
+		{"closest lobby", 1000, []testLobby{{ratings: []float64{1080}}, {ratings: []float64{1020}}, {ratings: []float64{950}}}, 1},
+		{"lobby average", 1000, []testLobby{{ratings: []float64{800, 1200}}, {ratings: []float64{1050}}}, 0},
+		{"started session", 1000, []testLobby{{started: true}, {ratings: []float64{1000}, started: true}}, -1},
+		{"full lobby", 1000, []testLobby{{ratings: []float64{1000, 1000, 1000, 1000}}}, -1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ms := NewMafiaServer(DefaultGameSettings(), DefaultMatchSettings(), nil, Stores{}, testLogger)
+			sessions := []*Session{}
+			for _, lobby := range test.lobbies {
+				var session *Session
+				if lobby.started {
+					session, _ = startedSession(t, DefaultGameSettings(), Stores{})
+				} else {
+					session = NewSession(DefaultGameSettings(), Stores{}, testLogger)
+					for i, rating := range lobby.ratings {
+						err := session.AddPlayer(fmt.Sprintf("player%d", i), rating, make(chan *pb.SessionEvent, PlayerEventsBuffer))
+						if err != nil {
+							t.Fatal(err)
+						}
+					}
+					for _, player := range session.players {
+						player.joinedAt = time.Now().Add(-lobby.wait)
+					}
+				}
+				ms.addSession(session)
+				sessions = append(sessions, session)
+			}
+
+			var expected *Session
+			if test.expected >= 0 {
+				expected = sessions[test.expected]
+			}
+			found := ms.findLobby(test.rating)
+			if found != expected {
+				t.Fatalf("expected lobby %d, got %v", test.expected, found)
+			}
+		})
+	}
+}
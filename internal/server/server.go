@@ -4,93 +4,299 @@ import (
 	"context"
 	"fmt"
 	"github.com/google/uuid"
-	"google.golang.org/grpc/metadata"
+	"log/slog"
+	"soa_hw_2/internal/auth"
 	"soa_hw_2/internal/pb"
+	"soa_hw_2/internal/store"
+	"sort"
 	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// PlayerEventsBuffer is how many events a player may lag behind before being disconnected.
+const PlayerEventsBuffer = 64
+
 type PlayerInfo struct {
-	username string
-	session  *Session
+	accountID uuid.UUID
+	username  string
+	session   *Session
 }
 
 type MafiaServer struct {
 	pb.UnimplementedMafiaServer
 
 	idToPlayerInfo map[uuid.UUID]*PlayerInfo
-	lastSession    *Session
+	sessions       map[uuid.UUID]*Session
+	moderators     map[uuid.UUID]*PlayerInfo
+	signer         *auth.Signer
+	settings       GameSettings
+	match          MatchSettings
+	stores         Stores
+	logger         *slog.Logger
+	isShuttingDown bool
 	mutex          sync.Mutex
 }
 
-func NewMafiaServer() *MafiaServer {
-	return &MafiaServer{idToPlayerInfo: make(map[uuid.UUID]*PlayerInfo), mutex: sync.Mutex{}}
+type Stores struct {
+	Accounts store.AccountStore
+	Sessions store.SessionStore
+	Games    store.GameStore
+	Ratings  store.RatingStore
+}
+
+func NewMafiaServer(settings GameSettings, match MatchSettings, signer *auth.Signer, stores Stores, logger *slog.Logger) *MafiaServer {
+	return &MafiaServer{
+		logger:         logger,
+		match:          match,
+		stores:         stores,
+		settings:       settings,
+		signer:         signer,
+		idToPlayerInfo: make(map[uuid.UUID]*PlayerInfo),
+		sessions:       make(map[uuid.UUID]*Session),
+		moderators:     make(map[uuid.UUID]*PlayerInfo),
+		mutex:          sync.Mutex{},
+	}
 }
 
 func (ms *MafiaServer) StartSession(req *pb.StartSessionRequest, s pb.Mafia_StartSessionServer) error {
+	account, err := ms.getAccount(s.Context())
+	if err != nil {
+		return err
+	}
+	username := account.Username
+	rating := ms.getRating(username)
+	_, span := tracer.Start(s.Context(), "MafiaServer.FindLobby", trace.WithAttributes(
+		attribute.String("mafia.player", username),
+		attribute.Float64("mafia.rating", rating),
+	))
+
 	ms.mutex.Lock()
+	if ms.isShuttingDown {
+		ms.mutex.Unlock()
+		err = status.Error(codes.Unavailable, "server is shutting down")
+		EndSpan(span, err)
+		return err
+	}
+
+	session := ms.findLobby(rating)
+	if session == nil {
+		session = NewSession(ms.settings, ms.stores, ms.logger)
+		ms.addSession(session)
+	}
+
+	events := make(chan *pb.SessionEvent, PlayerEventsBuffer)
+	err = session.AddPlayer(username, rating, events)
+	span.SetAttributes(attribute.String("mafia.session_id", session.id.String()))
+	EndSpan(span, err)
 
-	if ms.lastSession == nil {
-		ms.lastSession = NewSession()
+	if err != nil {
+		ms.mutex.Unlock()
+		return err
 	}
 
 	id := uuid.New()
-	ms.idToPlayerInfo[id] = &PlayerInfo{req.Username, ms.lastSession}
+	ms.idToPlayerInfo[id] = &PlayerInfo{account.ID, username, session}
+	ms.mutex.Unlock()
+	defer ms.removePlayerInfo(id, session)
 
-	events := make(chan pb.SessionEvent, 10)
-	err := ms.lastSession.AddPlayer(req.Username, events)
+	token, err := ms.signer.Issue(auth.Claims{
+		Kind:      auth.KindPlayer,
+		AccountID: account.ID,
+		PlayerID:  id,
+		SessionID: session.id,
+	})
+	if err != nil {
+		session.RemovePlayer(username)
+		return err
+	}
+
+	err = s.SendHeader(pb.WithToken(token))
 
 	if err != nil {
-		ms.mutex.Unlock()
+		session.RemovePlayer(username)
 		return err
 	}
 
-	session := ms.lastSession
+	return streamPlayerEvents(s, session, username, events)
+}
 
-	if ms.lastSession.isStarted {
-		ms.lastSession = nil
+func (ms *MafiaServer) Resume(req *pb.ResumeRequest, s pb.Mafia_ResumeServer) error {
+	account, err := ms.getAccount(s.Context())
+	if err != nil {
+		return err
+	}
+	username := account.Username
+
+	ms.mutex.Lock()
+	if ms.isShuttingDown {
+		ms.mutex.Unlock()
+		return status.Error(codes.Unavailable, "server is shutting down")
 	}
+
+	session, err := ms.findResumable(req.SessionId, username)
+	if err != nil {
+		ms.mutex.Unlock()
+		return err
+	}
+
+	events := make(chan *pb.SessionEvent, PlayerEventsBuffer)
+	err = session.ResumePlayer(username, events)
+	if err != nil {
+		ms.mutex.Unlock()
+		return err
+	}
+
+	id := uuid.New()
+	ms.idToPlayerInfo[id] = &PlayerInfo{account.ID, username, session}
 	ms.mutex.Unlock()
+	defer ms.removePlayerInfo(id, session)
 
-	err = s.SendHeader(pb.WithSessionID(id))
+	token, err := ms.signer.Issue(auth.Claims{
+		Kind:      auth.KindPlayer,
+		AccountID: account.ID,
+		PlayerID:  id,
+		SessionID: session.id,
+	})
+	if err != nil {
+		session.RemovePlayer(username)
+		return err
+	}
 
+	err = s.SendHeader(pb.WithToken(token))
 	if err != nil {
-		session.RemovePlayer(req.Username)
+		session.RemovePlayer(username)
 		return err
 	}
 
+	return streamPlayerEvents(s, session, username, events)
+}
+
+func (ms *MafiaServer) findResumable(sessionID string, username string) (*Session, error) {
+	if sessionID != "" {
+		id, err := uuid.Parse(sessionID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid session id is provided")
+		}
+		session, ok := ms.sessions[id]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "session %s is not found", id)
+		}
+		return session, nil
+	}
+
+	for _, session := range ms.sessions {
+		if session.CanResume(username) {
+			return session, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "no session to resume for %s", username)
+}
+
+// addSession registers the session until it ends, must be called under server mutex.
+func (ms *MafiaServer) addSession(session *Session) {
+	ms.sessions[session.id] = session
+	session.onEnd = func() {
+		ms.removeSession(session)
+	}
+}
+
+func (ms *MafiaServer) removeSession(session *Session) {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+	delete(ms.sessions, session.id)
+	for id, info := range ms.idToPlayerInfo {
+		if info.session == session {
+			delete(ms.idToPlayerInfo, id)
+		}
+	}
+	for id, info := range ms.moderators {
+		if info.session == session {
+			delete(ms.moderators, id)
+		}
+	}
+	session.Log().Debug("session is removed")
+}
+
+// removePlayerInfo forgets player whose event stream is over and the lobby if nobody is left in it.
+func (ms *MafiaServer) removePlayerInfo(id uuid.UUID, session *Session) {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+	delete(ms.idToPlayerInfo, id)
+	ms.removeAbandoned(session)
+}
+
+// removeAbandoned forgets open lobby without players and moderator, must be called under server mutex.
+func (ms *MafiaServer) removeAbandoned(session *Session) {
+	if session.IsAbandoned() {
+		delete(ms.sessions, session.id)
+		session.Log().Debug("abandoned lobby is removed")
+	}
+}
+
+type eventStream interface {
+	Send(*pb.SessionEvent) error
+	Context() context.Context
+}
+
+func streamPlayerEvents(s eventStream, session *Session, username string, events chan *pb.SessionEvent) error {
 	for {
 		select {
-		case event := <-events:
-			err := s.Send(&event)
+		case event, ok := <-events:
+			if !ok {
+				// channel is closed when game is finished or terminated, on shutdown,
+				// kick or when player is too slow
+				if session.IsEnded() {
+					return nil
+				}
+				session.RemovePlayer(username)
+				return fmt.Errorf("player is removed from session")
+			}
+			err := s.Send(event)
 			if err != nil {
-				session.RemovePlayer(req.Username)
+				session.RemovePlayer(username)
 				return err
 			}
 		case <-s.Context().Done():
-			session.RemovePlayer(req.Username)
+			session.RemovePlayer(username)
 			return nil
 		}
 
 	}
-
-	return nil
 }
 
 func (ms *MafiaServer) Vote(ctx context.Context, req *pb.VoteRequest) (*pb.Empty, error) {
 	playerInfo, err := ms.getPlayerInfo(ctx)
 	if err != nil {
+		observeRPC("vote", err)
 		return nil, err
 	}
-	err = playerInfo.session.Vote(playerInfo.username, req.Username)
+	ctx, span := playerInfo.session.StartSpan(ctx, "Session.Vote",
+		attribute.String("mafia.player", playerInfo.username),
+		attribute.String("mafia.target", req.Username),
+	)
+	err = playerInfo.session.Vote(ctx, playerInfo.username, req.Username)
+	EndSpan(span, err)
+	observeRPC("vote", err)
 	return &pb.Empty{}, err
 }
 
 func (ms *MafiaServer) Check(ctx context.Context, req *pb.CheckRequest) (*pb.CheckResponse, error) {
 	playerInfo, err := ms.getPlayerInfo(ctx)
 	if err != nil {
+		observeRPC("check", err)
 		return nil, err
 	}
-	resp, err := playerInfo.session.Check(playerInfo.username, req.Username)
+	ctx, span := playerInfo.session.StartSpan(ctx, "Session.Check",
+		attribute.String("mafia.player", playerInfo.username),
+		attribute.String("mafia.target", req.Username),
+	)
+	resp, err := playerInfo.session.Check(ctx, playerInfo.username, req.Username)
+	EndSpan(span, err)
+	observeRPC("check", err)
 	return resp, err
 }
 
@@ -99,24 +305,110 @@ func (ms *MafiaServer) GetSessionState(ctx context.Context, req *pb.Empty) (*pb.
 	if err != nil {
 		return nil, err
 	}
+	_, span := playerInfo.session.StartSpan(ctx, "Session.GetState", attribute.String("mafia.player", playerInfo.username))
 	resp, err := playerInfo.session.GetState(playerInfo.username)
+	EndSpan(span, err)
 	return resp, err
 }
 
-func (ms *MafiaServer) getPlayerInfo(ctx context.Context) (*PlayerInfo, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil, fmt.Errorf("metadata is not provided")
+func (ms *MafiaServer) SetReady(ctx context.Context, req *pb.ReadyRequest) (*pb.Empty, error) {
+	playerInfo, err := ms.getPlayerInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = playerInfo.session.SetReady(playerInfo.username, req.Ready)
+	return &pb.Empty{}, err
+}
+
+func (ms *MafiaServer) StartGame(ctx context.Context, req *pb.Empty) (*pb.Empty, error) {
+	playerInfo, err := ms.getPlayerInfo(ctx)
+	if err != nil {
+		return nil, err
 	}
-	id, err := pb.FetchSessionID(md)
+	err = playerInfo.session.StartByHost(playerInfo.username)
+	return &pb.Empty{}, err
+}
+
+func (ms *MafiaServer) SendMessage(ctx context.Context, req *pb.ChatRequest) (*pb.Empty, error) {
+	playerInfo, err := ms.getPlayerInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = playerInfo.session.SendMessage(playerInfo.username, req.Text)
+	return &pb.Empty{}, err
+}
+
+func (ms *MafiaServer) ListSessions(ctx context.Context, req *pb.Empty) (*pb.SessionList, error) {
+	_, err := ms.getAccount(ctx)
 	if err != nil {
 		return nil, err
 	}
+
+	summaries := []*pb.SessionSummary{}
+	for _, session := range ms.GetPlayerSessions() {
+		summary := session.GetSummary()
+		// lobbies left by all players are kept only while their moderator waits
+		if !summary.IsEnded && summary.PlayersCount > 0 {
+			summaries = append(summaries, summary)
+		}
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		a, b := summaries[i], summaries[j]
+		if a.IsStarted != b.IsStarted {
+			return !a.IsStarted
+		}
+		return a.SessionId < b.SessionId
+	})
+	return &pb.SessionList{Sessions: summaries}, nil
+}
+
+func (ms *MafiaServer) Spectate(req *pb.SpectateRequest, s pb.Mafia_SpectateServer) error {
+	sessionID, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		return fmt.Errorf("invalid session id is provided")
+	}
+
 	ms.mutex.Lock()
-	defer ms.mutex.Unlock()
-	info, ok := ms.idToPlayerInfo[id]
+	session, ok := ms.sessions[sessionID]
+	ms.mutex.Unlock()
 	if !ok {
-		return nil, fmt.Errorf("invalid id is provided")
+		return fmt.Errorf("session %s is not found", sessionID)
+	}
+
+	id := uuid.New()
+	events := make(chan *pb.SessionEvent, 10)
+	err = session.AddSpectator(id, events)
+	if err != nil {
+		return err
+	}
+	defer session.RemoveSpectator(id)
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			err := s.Send(event)
+			if err != nil {
+				return err
+			}
+		case <-s.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (ms *MafiaServer) getPlayerInfo(ctx context.Context) (*PlayerInfo, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok || claims.Kind != auth.KindPlayer {
+		return nil, fmt.Errorf("player token is not provided")
+	}
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+	info, ok := ms.idToPlayerInfo[claims.PlayerID]
+	if !ok || info.session.id != claims.SessionID || info.accountID != claims.AccountID {
+		return nil, fmt.Errorf("invalid token is provided")
 	}
 	return info, nil
 }
@@ -1,23 +1,52 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"github.com/google/uuid"
-	"log"
+	"log/slog"
 	"math/rand"
 	"soa_hw_2/internal/pb"
+	"soa_hw_2/internal/store"
 	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
-const MafiaCount = 1
-const SheriffCount = 1
-const CivilianCount = 2
+const DefaultPhaseDuration = 3 * time.Minute
+
+type GameSettings struct {
+	MafiaCount    int
+	SheriffCount  int
+	CivilianCount int
+	MinPlayers    int
+	PhaseDuration time.Duration
+}
+
+func DefaultGameSettings() GameSettings {
+	return GameSettings{
+		MafiaCount:    1,
+		SheriffCount:  1,
+		CivilianCount: 2,
+		MinPlayers:    3,
+		PhaseDuration: DefaultPhaseDuration,
+	}
+}
+
+func (g GameSettings) MaxPlayers() int {
+	return g.MafiaCount + g.SheriffCount + g.CivilianCount
+}
 
 type Player struct {
 	role     pb.Role
 	username string
 	liveness bool
-	ch       chan pb.SessionEvent
+	ch       chan *pb.SessionEvent
+	ready    bool
+	kicked   bool
+	rating   float64
+	joinedAt time.Time
 }
 
 type Session struct {
@@ -31,6 +60,29 @@ type Session struct {
 	isVoted    bool
 	isChecked  bool
 	winnerTeam pb.Team
+	host       string
+	lobby      []string
+	spectators map[uuid.UUID]chan *pb.SessionEvent
+	moderator  chan *pb.SessionEvent
+	moderators map[string]bool
+	settings   GameSettings
+	stores     Stores
+	logger     *slog.Logger
+	onEnd      func()
+
+	isSuspended bool
+	resumeTimer bool
+
+	record         *store.GameRecord
+	current        store.PhaseRecord
+	phaseStartedAt time.Time
+
+	phaseDuration time.Duration
+	timer         *time.Timer
+	timerEpoch    int
+	deadline      time.Time
+	remaining     time.Duration
+	isPaused      bool
 }
 
 type VoteShootInfo struct {
@@ -38,108 +90,260 @@ type VoteShootInfo struct {
 	chosen   string
 }
 
-func NewSession() *Session {
-	return &Session{uuid.New(), make(map[string]*Player), false, false, sync.Mutex{}, 0, make(map[string]string), false, false, pb.Team_UNKNOWN_TEAM}
+func NewSession(settings GameSettings, stores Stores, logger *slog.Logger) *Session {
+	id := uuid.New()
+	return &Session{
+		id:            id,
+		players:       make(map[string]*Player),
+		mutex:         sync.Mutex{},
+		votes:         make(map[string]string),
+		winnerTeam:    pb.Team_UNKNOWN_TEAM,
+		lobby:         []string{},
+		spectators:    make(map[uuid.UUID]chan *pb.SessionEvent),
+		moderators:    make(map[string]bool),
+		settings:      settings,
+		stores:        stores,
+		logger:        logger.With("session", id.String()),
+		phaseDuration: settings.PhaseDuration,
+	}
 }
 
-func (s *Session) AddPlayer(username string, ch chan pb.SessionEvent) error {
+func (s *Session) AddPlayer(username string, rating float64, ch chan *pb.SessionEvent) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 	if s.isEnded || s.isStarted {
 		return fmt.Errorf("No new players allowed to session")
 	}
 
-	mafiaCount := 0
-	sheriffCount := 0
-	civilianCount := 0
-
 	if username == "" {
 		return fmt.Errorf("Username is empty")
 	}
 
-	roles := []pb.Role{}
+	if len(s.players) >= s.settings.MaxPlayers() {
+		return fmt.Errorf("session is full")
+	}
+
+	_, ok := s.players[username]
+	if ok {
+		return fmt.Errorf("Username %s is registered in game yet", username)
+	}
+
+	s.players[username] = &Player{role: pb.Role_UNKNOWN_ROLE, username: username, liveness: true, ch: ch, rating: rating, joinedAt: time.Now()}
+	s.lobby = append(s.lobby, username)
+	if s.host == "" {
+		s.host = username
+	}
+
+	joinInfo := pb.SessionEvent_PlayerJoinInfo{Username: username}
+	joinEvent := pb.SessionEvent_JoinInfo{
+		JoinInfo: &joinInfo,
+	}
+	s.SendEvent(&pb.SessionEvent{EventInfo: &joinEvent})
+	s.SendLobbyUpdate()
+	s.Log().Info("player joined lobby", "player", username, "players", len(s.players))
+	return nil
+}
+
+func (s *Session) SetReady(username string, ready bool) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.isEnded || s.isStarted {
+		return fmt.Errorf("session is already started")
+	}
+
+	player, ok := s.players[username]
+	if !ok {
+		return fmt.Errorf("invalid player")
+	}
+
+	player.ready = ready
+	s.SendLobbyUpdate()
+
+	if len(s.players) == s.settings.MaxPlayers() && s.IsAllReady() {
+		s.Start()
+	}
+	return nil
+}
+
+func (s *Session) StartByHost(username string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.isEnded || s.isStarted {
+		return fmt.Errorf("session is already started")
+	}
+	if username != s.host {
+		return fmt.Errorf("only host can start the game")
+	}
+	if len(s.players) < s.settings.MinPlayers {
+		return fmt.Errorf("not enough players: %d, needed: %d", len(s.players), s.settings.MinPlayers)
+	}
+	if !s.IsAllReady() {
+		return fmt.Errorf("not all players are ready")
+	}
+
+	s.Start()
+	return nil
+}
+
+func (s *Session) IsAbandoned() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return !s.isStarted && len(s.players) == 0 && s.moderator == nil
+}
+
+func (s *Session) IsEnded() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.isEnded
+}
 
+func (s *Session) IsAllReady() bool {
 	for _, player := range s.players {
-		if player.username == username {
-			return fmt.Errorf("Username %s is registered in game yet", username)
+		if !player.ready {
+			return false
 		}
-		if player.role == pb.Role_MAFIA_ROLE {
-			mafiaCount++
-		} else if player.role == pb.Role_SHERIFF {
-			sheriffCount++
-		} else {
-			civilianCount++
+	}
+	return true
+}
+
+func (s *Session) Start() {
+	s.isStarted = true
+	s.state = 1
+	s.AssignRoles()
+	s.Log().Info("game started", "players", len(s.players))
+	s.StartRecord()
+	s.ObservePhase()
+	for _, player := range s.players {
+		state, _ := s.GetStateUnlocked(player.username)
+		event := pb.SessionEvent_SessionStartInfo{
+			Role:      state.Player.Role,
+			Players:   state.Players,
+			SessionId: s.id.String(),
 		}
+		info := pb.SessionEvent_StartInfo{StartInfo: &event}
+		s.SendToPlayer(player, &pb.SessionEvent{EventInfo: &info})
 	}
+	for _, ch := range s.spectators {
+		s.SendSpectatorStart(ch)
+	}
+	if s.moderator != nil {
+		s.SendModeratorStart()
+	}
+	s.StartTimer()
+	s.SendPhaseUpdate()
+	s.Persist()
+}
 
-	for i := mafiaCount; i < MafiaCount; i++ {
+func (s *Session) AssignRoles() {
+	roles := []pb.Role{}
+	for i := 0; i < s.settings.MafiaCount; i++ {
 		roles = append(roles, pb.Role_MAFIA_ROLE)
 	}
-
-	for i := sheriffCount; i < SheriffCount; i++ {
+	for i := 0; i < s.settings.SheriffCount; i++ {
 		roles = append(roles, pb.Role_SHERIFF)
 	}
-
-	for i := civilianCount; i < CivilianCount; i++ {
+	for len(roles) < len(s.lobby) {
 		roles = append(roles, pb.Role_CIVILIAN)
 	}
 
-	role := roles[rand.Intn(len(roles))]
+	rand.Shuffle(len(roles), func(i, j int) {
+		roles[i], roles[j] = roles[j], roles[i]
+	})
 
-	s.players[username] = &Player{role, username, true, ch}
+	for i, username := range s.lobby {
+		s.players[username].role = roles[i]
+	}
+}
 
-	joinInfo := pb.SessionEvent_PlayerJoinInfo{Username: username}
-	joinEvent := pb.SessionEvent_JoinInfo{
-		&joinInfo,
-	}
-	s.SendEvent(pb.SessionEvent{EventInfo: &joinEvent})
-	log.Printf("roles: %d, id: %s", len(roles), s.id)
-	if len(roles) == 1 {
-		log.Printf("game with id: %s started", s.id)
-		s.isStarted = true
-		s.state = 1
-		for _, player := range s.players {
-			state, _ := s.GetStateUnlocked(player.username)
-			event := pb.SessionEvent_SessionStartInfo{
-				Role:    state.Player.Role,
-				Players: state.Players,
-			}
-			info := pb.SessionEvent_StartInfo{StartInfo: &event}
-			player.ch <- pb.SessionEvent{EventInfo: &info}
-		}
+func (s *Session) SendLobbyUpdate() {
+	players := []*pb.SessionEvent_LobbyPlayer{}
+	for _, username := range s.lobby {
+		players = append(players, &pb.SessionEvent_LobbyPlayer{
+			Username: username,
+			Ready:    s.players[username].ready,
+		})
+	}
 
+	event := pb.SessionEvent_LobbyUpdateInfo{
+		Host:       s.host,
+		Players:    players,
+		MinPlayers: int32(s.settings.MinPlayers),
+		MaxPlayers: int32(s.settings.MaxPlayers()),
+		SessionId:  s.id.String(),
 	}
-	return nil
+	info := pb.SessionEvent_LobbyUpdate{LobbyUpdate: &event}
+	s.SendEvent(&pb.SessionEvent{EventInfo: &info})
 }
 
 func (s *Session) RemovePlayer(username string) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
+	if !s.isStarted && !s.isEnded {
+		s.RemoveFromLobby(username)
+		return
+	}
+
 	err := s.ValidateState()
 	if err != nil {
 		return
 	}
 
 	player, ok := s.players[username]
+	if ok {
+		player.ch = nil
+	}
 	if ok && player.liveness {
 		player.liveness = false
 
-		log.Printf("player %s left session", username)
+		s.Log().Info("player left game", "player", username)
+		s.RecordRemoved(username)
 
 		leftInfo := pb.SessionEvent_PlayerLeftInfo{Username: username}
 		leftEvent := pb.SessionEvent_LeftInfo{
-			&leftInfo,
+			LeftInfo: &leftInfo,
+		}
+		s.SendEvent(&pb.SessionEvent{EventInfo: &leftEvent})
+
+		s.UpdateState(context.Background())
+		s.Persist()
+	}
+}
+
+func (s *Session) RemoveFromLobby(username string) {
+	_, ok := s.players[username]
+	if !ok {
+		return
+	}
+
+	delete(s.players, username)
+	for i, name := range s.lobby {
+		if name == username {
+			s.lobby = append(s.lobby[:i], s.lobby[i+1:]...)
+			break
+		}
+	}
+
+	if s.host == username {
+		s.host = ""
+		if len(s.lobby) > 0 {
+			s.host = s.lobby[0]
 		}
-		s.SendEvent(pb.SessionEvent{EventInfo: &leftEvent})
+	}
 
-		s.UpdateState()
+	s.Log().Info("player left lobby", "player", username)
+
+	leftInfo := pb.SessionEvent_PlayerLeftInfo{Username: username}
+	leftEvent := pb.SessionEvent_LeftInfo{
+		LeftInfo: &leftInfo,
 	}
+	s.SendEvent(&pb.SessionEvent{EventInfo: &leftEvent})
+	s.SendLobbyUpdate()
 }
 
-func (s *Session) Vote(username string, voted string) error {
+func (s *Session) Vote(ctx context.Context, username string, voted string) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
-	log.Printf("Vote from %s to %s", username, voted)
+	s.Log().Debug("vote", "player", username, "target", voted)
 	err := s.ValidateState()
 	if err != nil {
 		return err
@@ -168,6 +372,7 @@ func (s *Session) Vote(username string, voted string) error {
 			return fmt.Errorf("already voted")
 		}
 		s.votes[username] = voted
+		s.SendNightAction(pb.NightAction_MAFIA_VOTE, username, votedPlayer)
 	} else {
 		_, ok := s.votes[username]
 		if ok {
@@ -175,7 +380,8 @@ func (s *Session) Vote(username string, voted string) error {
 		}
 		s.votes[username] = voted
 	}
-	s.UpdateState()
+	s.UpdateState(ctx)
+	s.Persist()
 	return nil
 }
 
@@ -199,13 +405,13 @@ func (s *Session) GetCounts() (int, int, int, []*Player) {
 	return mafiaCount, civilianCount, sheriffCount, alive
 }
 
-func (s *Session) UpdateState() {
+func (s *Session) UpdateState(ctx context.Context) {
 	err := s.ValidateState()
 	if err != nil {
 		return
 	}
 
-	mafiaCount, civilianCount, sheriffCount, alive := s.GetCounts()
+	mafiaCount, _, sheriffCount, alive := s.GetCounts()
 
 	needed := mafiaCount
 	if s.state%2 == 0 {
@@ -217,69 +423,95 @@ func (s *Session) UpdateState() {
 	if !s.isChecked && s.state%2 == 1 {
 		return
 	}
-	log.Printf("voted: %d, needed: %d", len(s.votes), needed)
+	s.Log().Debug("votes counted", "voted", len(s.votes), "needed", needed)
 	if len(s.votes) > 0 && len(s.votes) == needed && (s.isChecked || s.state%2 == 0) {
-		max, voted := 0, ""
-		for _, player := range alive {
-			cur := 0
-			for _, result := range s.votes {
-				if player.username == result {
-					cur++
-				}
-			}
-			if cur > max {
-				max, voted = cur, player.username
+		s.ResolvePhase(ctx)
+	}
+}
+
+func (s *Session) ResolvePhase(ctx context.Context) {
+	_, span := s.StartSpan(ctx, "Session.ResolvePhase",
+		attribute.String("mafia.phase", s.GetPhase().String()),
+		attribute.Int("mafia.round", s.state/2+1),
+		attribute.Int("mafia.votes", len(s.votes)),
+	)
+	defer span.End()
+
+	_, _, _, alive := s.GetCounts()
+
+	max, voted := 0, ""
+	for _, player := range alive {
+		cur := 0
+		for _, result := range s.votes {
+			if player.username == result {
+				cur++
 			}
 		}
+		if cur > max {
+			max, voted = cur, player.username
+		}
+	}
 
+	if voted != "" {
 		s.players[voted].liveness = false
-		s.votes = make(map[string]string)
-
-		s.state++
-
-		log.Printf("state changed: %d", s.state)
 
 		voteInfo := pb.SessionEvent_VoteInfo{Username: voted}
 		voteEvent := pb.SessionEvent_VoteInfo_{
-			&voteInfo,
+			VoteInfo: &voteInfo,
 		}
-		s.SendEvent(pb.SessionEvent{EventInfo: &voteEvent})
-	} else {
-		return
+		s.SendEvent(&pb.SessionEvent{EventInfo: &voteEvent})
 	}
 
-	if sheriffCount != 0 {
-		s.isChecked = false
-	}
+	s.Log().Info("phase resolved", "eliminated", voted, "votes", len(s.votes))
+	span.SetAttributes(attribute.String("mafia.eliminated", voted))
+	s.RecordPhase(voted)
+	s.ObservePhase()
+	s.votes = make(map[string]string)
+	s.isChecked = false
+	s.state++
+	s.ResetPhaseRecord()
 
-	mafiaCount, civilianCount, sheriffCount, _ = s.GetCounts()
+	s.CheckFinish()
 
-	if mafiaCount == 0 {
-		log.Printf("game is ended: civilians wins")
-		players := s.GetAllPlayers()
-		event := pb.SessionEvent_SessionFinishInfo{
-			Winners: pb.Team_CIVILIANS,
-			Players: players,
-		}
-		info := pb.SessionEvent_FinishInfo{FinishInfo: &event}
-
-		s.SendEvent(pb.SessionEvent{EventInfo: &info})
-		s.isEnded = true
+	if !s.isEnded {
+		s.StartTimer()
+		s.SendPhaseUpdate()
 	}
+	s.Persist()
+}
 
-	if mafiaCount == civilianCount+sheriffCount {
-		log.Printf("game is ended: mafia wins")
-		players := s.GetAllPlayers()
-		event := pb.SessionEvent_SessionFinishInfo{
-			Winners: pb.Team_MAFIA,
-			Players: players,
-		}
-		info := pb.SessionEvent_FinishInfo{FinishInfo: &event}
+func (s *Session) CheckFinish() {
+	mafiaCount, civilianCount, sheriffCount, _ := s.GetCounts()
 
-		s.SendEvent(pb.SessionEvent{EventInfo: &info})
-		s.isEnded = true
+	if mafiaCount == 0 {
+		s.Finish(pb.Team_CIVILIANS)
+	} else if mafiaCount >= civilianCount+sheriffCount {
+		s.Finish(pb.Team_MAFIA)
 	}
+}
 
+func (s *Session) Finish(winners pb.Team) {
+	players := s.GetAllPlayers()
+	event := pb.SessionEvent_SessionFinishInfo{
+		Winners: winners,
+		Players: players,
+	}
+	info := pb.SessionEvent_FinishInfo{FinishInfo: &event}
+
+	s.SendEvent(&pb.SessionEvent{EventInfo: &info})
+	s.Log().Info("game finished", "winner", winners.String())
+	s.ObserveFinish(winners)
+	s.SaveRecord(winners)
+	s.winnerTeam = winners
+	s.isEnded = true
+	s.StopTimer()
+	s.Persist()
+	// streams of finished game end here, server forgets the session and can't reach them on shutdown
+	s.CloseUnlocked()
+	if s.onEnd != nil {
+		// server mutex is taken before session one, so server forgets session asynchronously
+		go s.onEnd()
+	}
 }
 
 func (s *Session) ValidateState() error {
@@ -289,50 +521,60 @@ func (s *Session) ValidateState() error {
 	if s.isEnded {
 		return fmt.Errorf("session is ended")
 	}
+	if s.isSuspended {
+		return fmt.Errorf("session is suspended")
+	}
 	return nil
 }
 
-func (s *Session) Check(username string, checked string) (*pb.CheckResponse, error) {
+func (s *Session) Check(ctx context.Context, username string, checked string) (*pb.CheckResponse, error) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	log.Printf("Check from %s to %s", username, checked)
+	s.Log().Debug("check", "player", username, "target", checked)
 
 	err := s.ValidateState()
 	if err != nil {
-		log.Printf("error: %s", err)
+		s.Log().Debug("check rejected", "player", username, "target", checked, "error", err)
 		return nil, err
 	}
 
-	player, _ := s.players[username]
+	player, ok := s.players[username]
 
-	if !player.liveness || player.role != pb.Role_SHERIFF || s.isChecked {
+	if !ok || !player.liveness || player.role != pb.Role_SHERIFF || s.isChecked {
 		err = fmt.Errorf("player can't check")
-		log.Printf("error: %s", err)
+		s.Log().Debug("check rejected", "player", username, "target", checked, "error", err)
 		return nil, err
 	}
 
 	if username == checked {
 		err = fmt.Errorf("player can't check himself")
-		log.Printf("error: %s", err)
+		s.Log().Debug("check rejected", "player", username, "target", checked, "error", err)
 		return nil, err
 	}
 
 	checkedPlayer, ok := s.players[checked]
 	if !ok || !checkedPlayer.liveness {
 		err = fmt.Errorf("player can't check himself")
-		log.Printf("error: %s", err)
+		s.Log().Debug("check rejected", "player", username, "target", checked, "error", err)
 		return nil, err
 	}
 
 	s.isChecked = true
-	s.UpdateState()
+	s.RecordCheck(username, checkedPlayer)
+	s.SendNightAction(pb.NightAction_SHERIFF_CHECK, username, checkedPlayer)
+	s.UpdateState(ctx)
+	s.Persist()
 	return &pb.CheckResponse{Username: checked, Role: checkedPlayer.role}, nil
 }
 
 func (s *Session) GetState(username string) (*pb.SessionState, error) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
+	return s.GetStateUnlocked(username)
+}
+
+func (s *Session) GetStateUnlocked(username string) (*pb.SessionState, error) {
 	player, ok := s.players[username]
 	if !ok {
 		return nil, fmt.Errorf("invalid player")
@@ -351,7 +593,7 @@ func (s *Session) GetState(username string) (*pb.SessionState, error) {
 			Username: p.username,
 			Liveness: p.liveness,
 		}
-		if prPl.Username != username {
+		if prPl.Username != username && player.liveness {
 			prPl.Role = pb.Role_UNKNOWN_ROLE
 		}
 		protoPlayers = append(protoPlayers, prPl)
@@ -361,21 +603,13 @@ func (s *Session) GetState(username string) (*pb.SessionState, error) {
 		Player:     protoPlayer,
 		Players:    protoPlayers,
 		WinnerTeam: s.winnerTeam,
+		SessionId:  s.id.String(),
+		Phase:      s.GetPhase(),
 	}
 	return &state, nil
 }
 
-func (s *Session) GetStateUnlocked(username string) (*pb.SessionState, error) {
-	player, ok := s.players[username]
-	if !ok {
-		return nil, fmt.Errorf("invalid player")
-	}
-
-	protoPlayer := &pb.Player{
-		Role:     player.role,
-		Username: player.username,
-		Liveness: player.liveness,
-	}
+func (s *Session) GetAllPlayers() []*pb.Player {
 	protoPlayers := []*pb.Player{}
 
 	for _, p := range s.players {
@@ -384,36 +618,222 @@ func (s *Session) GetStateUnlocked(username string) (*pb.SessionState, error) {
 			Username: p.username,
 			Liveness: p.liveness,
 		}
-		if prPl.Username != username {
-			prPl.Role = pb.Role_UNKNOWN_ROLE
-		}
 		protoPlayers = append(protoPlayers, prPl)
 	}
+	return protoPlayers
+}
 
-	state := pb.SessionState{
-		Player:     protoPlayer,
-		Players:    protoPlayers,
-		WinnerTeam: s.winnerTeam,
+func (s *Session) GetMaskedPlayers() []*pb.Player {
+	protoPlayers := s.GetAllPlayers()
+	for _, p := range protoPlayers {
+		p.Role = pb.Role_UNKNOWN_ROLE
 	}
-	return &state, nil
+	return protoPlayers
 }
 
-func (s *Session) GetAllPlayers() []*pb.Player {
-	protoPlayers := []*pb.Player{}
+func (s *Session) GetPhase() pb.Phase {
+	if !s.isStarted || s.isEnded {
+		return pb.Phase_UNKNOWN_PHASE
+	}
+	if s.state%2 == 1 {
+		return pb.Phase_NIGHT
+	}
+	return pb.Phase_DAY
+}
 
+func (s *Session) Log() *slog.Logger {
+	if !s.isStarted || s.isEnded {
+		return s.logger
+	}
+	return s.logger.With("phase", s.GetPhase().String(), "round", s.state/2+1)
+}
+
+func (s *Session) GetPhaseEvent() *pb.SessionEvent {
+	event := pb.SessionEvent_PhaseInfo{
+		Phase:       s.GetPhase(),
+		Number:      int32(s.state/2 + 1),
+		SecondsLeft: int32(s.GetTimeLeft().Seconds()),
+		Paused:      s.isPaused,
+	}
+	info := pb.SessionEvent_PhaseInfo_{PhaseInfo: &event}
+	return &pb.SessionEvent{EventInfo: &info}
+}
+
+func (s *Session) SendPhaseUpdate() {
+	s.SendEvent(s.GetPhaseEvent())
+}
+
+func (s *Session) SendMessage(username string, text string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.isEnded {
+		return fmt.Errorf("session is ended")
+	}
+	if text == "" {
+		return fmt.Errorf("message is empty")
+	}
+
+	player, ok := s.players[username]
+	if !ok {
+		return fmt.Errorf("invalid player")
+	}
+
+	if !player.liveness {
+		chatInfo := pb.SessionEvent_ChatInfo{Username: username, Text: text, Channel: pb.ChatChannel_DEAD_CHANNEL}
+		chatEvent := pb.SessionEvent_ChatInfo_{ChatInfo: &chatInfo}
+		s.SendDeadEvent(&pb.SessionEvent{EventInfo: &chatEvent})
+		return nil
+	}
+
+	if s.GetPhase() == pb.Phase_NIGHT {
+		return fmt.Errorf("chat is not allowed at night")
+	}
+
+	chatInfo := pb.SessionEvent_ChatInfo{Username: username, Text: text, Channel: pb.ChatChannel_PUBLIC_CHANNEL}
+	chatEvent := pb.SessionEvent_ChatInfo_{ChatInfo: &chatInfo}
+	s.SendEvent(&pb.SessionEvent{EventInfo: &chatEvent})
+	return nil
+}
+
+func (s *Session) SendNightAction(action pb.NightAction, username string, target *Player) {
+	event := pb.SessionEvent_NightActionInfo{
+		Action:   action,
+		Username: username,
+		Target:   target.username,
+	}
+	if action == pb.NightAction_SHERIFF_CHECK {
+		event.TargetRole = target.role
+	}
+	info := pb.SessionEvent_NightActionInfo_{NightActionInfo: &event}
+	s.SendDeadEvent(&pb.SessionEvent{EventInfo: &info})
+}
+
+func (s *Session) SendDeadEvent(event *pb.SessionEvent) {
+	s.RecordEvent(event)
 	for _, p := range s.players {
-		prPl := &pb.Player{
-			Role:     p.role,
-			Username: p.username,
-			Liveness: p.liveness,
+		if !p.liveness {
+			s.SendToPlayer(p, event)
 		}
-		protoPlayers = append(protoPlayers, prPl)
 	}
-	return protoPlayers
+	if s.moderator != nil {
+		SendNonBlocking(s.moderator, event)
+	}
 }
 
-func (s *Session) SendEvent(event pb.SessionEvent) {
+func (s *Session) AddSpectator(id uuid.UUID, ch chan *pb.SessionEvent) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.isEnded {
+		return fmt.Errorf("session is ended")
+	}
+
+	s.spectators[id] = ch
+	s.Log().Info("spectator joined", "spectator", id.String())
+
+	if !s.isStarted {
+		s.SendLobbyUpdate()
+		return nil
+	}
+	s.SendSpectatorStart(ch)
+	SendNonBlocking(ch, s.GetPhaseEvent())
+	return nil
+}
+
+func (s *Session) RemoveSpectator(id uuid.UUID) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.spectators, id)
+}
+
+func (s *Session) SendSpectatorStart(ch chan *pb.SessionEvent) {
+	event := pb.SessionEvent_SessionStartInfo{
+		Role:      pb.Role_UNKNOWN_ROLE,
+		Players:   s.GetMaskedPlayers(),
+		SessionId: s.id.String(),
+	}
+	info := pb.SessionEvent_StartInfo{StartInfo: &event}
+	SendNonBlocking(ch, &pb.SessionEvent{EventInfo: &info})
+}
+
+func (s *Session) SendEvent(event *pb.SessionEvent) {
+	s.RecordEvent(event)
 	for _, p := range s.players {
-		p.ch <- event
+		s.SendToPlayer(p, event)
+	}
+	for _, ch := range s.spectators {
+		SendNonBlocking(ch, event)
+	}
+	if s.moderator != nil {
+		SendNonBlocking(s.moderator, event)
+	}
+}
+
+// SendToPlayer never blocks the session: player whose event buffer is full is disconnected,
+// and its stream removes it from the session like any other disconnected player.
+func (s *Session) SendToPlayer(player *Player, event *pb.SessionEvent) {
+	if player.ch == nil {
+		return
+	}
+	select {
+	case player.ch <- event:
+	default:
+		s.Log().Warn("player is too slow to receive events, disconnecting", "player", player.username)
+		close(player.ch)
+		player.ch = nil
+	}
+}
+
+func SendNonBlocking(ch chan *pb.SessionEvent, event *pb.SessionEvent) {
+	select {
+	case ch <- event:
+	default:
+	}
+}
+
+func (s *Session) StartTimer() {
+	s.StopTimer()
+	if s.phaseDuration == 0 {
+		return
+	}
+	s.ArmTimer(s.phaseDuration)
+}
+
+func (s *Session) ArmTimer(duration time.Duration) {
+	s.timerEpoch++
+	epoch := s.timerEpoch
+	s.isPaused = false
+	s.resumeTimer = false
+	s.deadline = time.Now().Add(duration)
+	s.timer = time.AfterFunc(duration, func() {
+		s.OnTimer(epoch)
+	})
+}
+
+func (s *Session) StopTimer() {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	s.isPaused = false
+}
+
+func (s *Session) OnTimer(epoch int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if epoch != s.timerEpoch || s.ValidateState() != nil {
+		return
+	}
+
+	s.Log().Info("phase time is over")
+	s.ResolvePhase(context.Background())
+}
+
+func (s *Session) GetTimeLeft() time.Duration {
+	if s.isPaused {
+		return s.remaining
+	}
+	if s.timer == nil {
+		return 0
 	}
+	return time.Until(s.deadline)
 }
@@ -0,0 +1,299 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"soa_hw_2/internal/pb"
+	"soa_hw_2/internal/store"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// lobbySession returns a lobby of players named player0, player1 and so on, nobody is ready.
+func lobbySession(t *testing.T, players int) *Session {
+	s := NewSession(DefaultGameSettings(), Stores{}, testLogger)
+	for i := 0; i < players; i++ {
+		err := s.AddPlayer(fmt.Sprintf("player%d", i), store.DefaultRating, make(chan *pb.SessionEvent, PlayerEventsBuffer))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Cleanup(func() {
+		s.mutex.Lock()
+		s.StopTimer()
+		s.mutex.Unlock()
+	})
+	return s
+}
+
+func setReady(t *testing.T, s *Session, ready bool, usernames ...string) {
+	for _, username := range usernames {
+		err := s.SetReady(username, ready)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestSetReady(t *testing.T) {
+	tests := []struct {
+		name     string
+		players  int
+		act      func(t *testing.T, s *Session) error
+		started  bool
+		expected string
+	}{
+		{"full lobby is ready", 4, func(t *testing.T, s *Session) error {
+			return s.SetReady("player3", true)
+		}, true, ""},
+		{"full lobby with player not ready", 4, func(t *testing.T, s *Session) error {
+			return s.SetReady("player3", false)
+		}, false, ""},
+		{"lobby is not full", 3, func(t *testing.T, s *Session) error {
+			return s.SetReady("player2", true)
+		}, false, ""},
+		{"readiness is cancelled", 4, func(t *testing.T, s *Session) error {
+			setReady(t, s, false, "player0")
+			return s.SetReady("player3", true)
+		}, false, ""},
+		{"unknown player", 4, func(t *testing.T, s *Session) error {
+			return s.SetReady("stranger", true)
+		}, false, "invalid player"},
+		{"game is started", 4, func(t *testing.T, s *Session) error {
+			setReady(t, s, true, "player3")
+			return s.SetReady("player3", false)
+		}, true, "session is already started"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := lobbySession(t, test.players)
+			setReady(t, s, true, "player0", "player1", "player2")
+
+			err := test.act(t, s)
+			if test.expected == "" && err != nil {
+				t.Fatal(err)
+			}
+			if test.expected != "" && (err == nil || err.Error() != test.expected) {
+				t.Fatalf("expected %q, got %v", test.expected, err)
+			}
+			if s.isStarted != test.started {
+				t.Fatalf("expected started %t, got %t", test.started, s.isStarted)
+			}
+		})
+	}
+}
+
+func TestStartByHost(t *testing.T) {
+	tests := []struct {
+		name     string
+		players  int
+		ready    []string
+		act      func(t *testing.T, s *Session) error
+		expected string
+	}{
+		{"host starts", 3, []string{"player0", "player1", "player2"}, func(t *testing.T, s *Session) error {
+			return s.StartByHost("player0")
+		}, ""},
+		{"player is not host", 3, []string{"player0", "player1", "player2"}, func(t *testing.T, s *Session) error {
+			return s.StartByHost("player1")
+		}, "only host can start the game"},
+		{"not enough players", 2, []string{"player0", "player1"}, func(t *testing.T, s *Session) error {
+			return s.StartByHost("player0")
+		}, "not enough players: 2, needed: 3"},
+		{"not all players are ready", 3, []string{"player0", "player1"}, func(t *testing.T, s *Session) error {
+			return s.StartByHost("player0")
+		}, "not all players are ready"},
+		{"next player becomes host", 4, []string{"player1", "player2", "player3"}, func(t *testing.T, s *Session) error {
+			s.RemovePlayer("player0")
+			return s.StartByHost("player1")
+		}, ""},
+		{"game is started", 3, []string{"player0", "player1", "player2"}, func(t *testing.T, s *Session) error {
+			err := s.StartByHost("player0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			return s.StartByHost("player0")
+		}, "session is already started"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := lobbySession(t, test.players)
+			setReady(t, s, true, test.ready...)
+
+			err := test.act(t, s)
+			if test.expected != "" {
+				if err == nil || err.Error() != test.expected {
+					t.Fatalf("expected %q, got %v", test.expected, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !s.isStarted {
+				t.Fatal("expected started game")
+			}
+			for username, player := range s.players {
+				if player.role == pb.Role_UNKNOWN_ROLE {
+					t.Fatalf("expected role of %s", username)
+				}
+			}
+		})
+	}
+}
+
+// withDeadCivilian kills first civilian by mafia at night and moves game to day, or by moderator otherwise.
+func withDeadCivilian(t *testing.T, day bool) (*Session, map[pb.Role][]string) {
+	s, roles := startedSession(t, DefaultGameSettings(), Stores{})
+	if !day {
+		err := s.KillPlayer(roles[pb.Role_CIVILIAN][0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s, roles
+	}
+
+	err := s.Vote(context.Background(), roles[pb.Role_MAFIA_ROLE][0], roles[pb.Role_CIVILIAN][0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.Check(context.Background(), roles[pb.Role_SHERIFF][0], roles[pb.Role_CIVILIAN][1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, roles
+}
+
+func chatMessages(ch chan *pb.SessionEvent) []*pb.SessionEvent_ChatInfo {
+	messages := []*pb.SessionEvent_ChatInfo{}
+	for {
+		select {
+		case event := <-ch:
+			if event.GetChatInfo() != nil {
+				messages = append(messages, event.GetChatInfo())
+			}
+		default:
+			return messages
+		}
+	}
+}
+
+func TestGetState(t *testing.T) {
+	tests := []struct {
+		name     string
+		role     pb.Role
+		index    int
+		expected string
+		visible  bool
+	}{
+		{"alive civilian", pb.Role_CIVILIAN, 1, "", false},
+		{"alive mafia", pb.Role_MAFIA_ROLE, 0, "", false},
+		{"alive sheriff", pb.Role_SHERIFF, 0, "", false},
+		{"dead civilian", pb.Role_CIVILIAN, 0, "", true},
+		{"unknown player", pb.Role_UNKNOWN_ROLE, 0, "invalid player", false},
+	}
+
+	s, roles := withDeadCivilian(t, true)
+	roles[pb.Role_UNKNOWN_ROLE] = []string{"stranger"}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			username := roles[test.role][test.index]
+			s.mutex.Lock()
+			state, err := s.GetStateUnlocked(username)
+			s.mutex.Unlock()
+			if test.expected != "" {
+				if err == nil || err.Error() != test.expected {
+					t.Fatalf("expected %q, got %v", test.expected, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if state.Player.Username != username || state.Player.Role != test.role {
+				t.Fatalf("expected own role %s, got %v", test.role, state.Player)
+			}
+			for _, player := range state.Players {
+				visible := player.Role != pb.Role_UNKNOWN_ROLE
+				if player.Username != username && visible != test.visible {
+					t.Fatalf("expected role of %s visible: %t, got %s", player.Username, test.visible, player.Role)
+				}
+				if player.Username == username && player.Role != test.role {
+					t.Fatalf("expected own role %s in players, got %s", test.role, player.Role)
+				}
+			}
+		})
+	}
+}
+
+func TestSendMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		day      bool
+		role     pb.Role
+		index    int
+		text     string
+		expected string
+		channel  pb.ChatChannel
+	}{
+		{"alive player at day", true, pb.Role_MAFIA_ROLE, 0, "hello", "", pb.ChatChannel_PUBLIC_CHANNEL},
+		{"alive player at night", false, pb.Role_SHERIFF, 0, "hello", "chat is not allowed at night", 0},
+		{"dead player at day", true, pb.Role_CIVILIAN, 0, "hello", "", pb.ChatChannel_DEAD_CHANNEL},
+		{"dead player at night", false, pb.Role_CIVILIAN, 0, "hello", "", pb.ChatChannel_DEAD_CHANNEL},
+		{"empty message", true, pb.Role_MAFIA_ROLE, 0, "", "message is empty", 0},
+		{"unknown player", true, pb.Role_UNKNOWN_ROLE, 0, "hello", "invalid player", 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, roles := withDeadCivilian(t, test.day)
+			roles[pb.Role_UNKNOWN_ROLE] = []string{"stranger"}
+			spectator := make(chan *pb.SessionEvent, PlayerEventsBuffer)
+			err := s.AddSpectator(uuid.New(), spectator)
+			if err != nil {
+				t.Fatal(err)
+			}
+			moderator := make(chan *pb.SessionEvent, PlayerEventsBuffer)
+			s.mutex.Lock()
+			s.moderator = moderator
+			s.mutex.Unlock()
+
+			sender := roles[test.role][test.index]
+			err = s.SendMessage(sender, test.text)
+			if test.expected != "" {
+				if err == nil || err.Error() != test.expected {
+					t.Fatalf("expected %q, got %v", test.expected, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			receivers := map[string]chan *pb.SessionEvent{"spectator": spectator, "moderator": moderator}
+			for username, player := range s.players {
+				receivers[username] = player.ch
+			}
+			for name, ch := range receivers {
+				player, isPlayer := s.players[name]
+				// dead chat is hidden from living players and spectators
+				receives := test.channel == pb.ChatChannel_PUBLIC_CHANNEL || name == "moderator" || isPlayer && !player.liveness
+
+				messages := chatMessages(ch)
+				if !receives {
+					if len(messages) != 0 {
+						t.Fatalf("expected no messages for %s, got %v", name, messages)
+					}
+					continue
+				}
+				if len(messages) != 1 || messages[0].Username != sender || messages[0].Text != test.text || messages[0].Channel != test.channel {
+					t.Fatalf("expected %s message of %s for %s, got %v", test.channel, sender, name, messages)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"soa_hw_2/internal/pb"
+	"time"
+)
+
+const ShutdownPollInterval = 500 * time.Millisecond
+
+func (ms *MafiaServer) IsShuttingDown() bool {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+	return ms.isShuttingDown
+}
+
+func (ms *MafiaServer) Shutdown(timeout time.Duration) {
+	ms.mutex.Lock()
+	ms.isShuttingDown = true
+	sessions := make([]*Session, 0, len(ms.sessions))
+	for _, session := range ms.sessions {
+		sessions = append(sessions, session)
+	}
+	ms.mutex.Unlock()
+
+	ms.logger.Info("server is shutting down", "timeout", timeout.String(), "sessions", len(sessions))
+
+	for _, session := range sessions {
+		session.NotifyShutdown(timeout)
+		if !session.IsActive() {
+			session.Close()
+		}
+	}
+
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) && countActive(sessions) > 0 {
+		time.Sleep(ShutdownPollInterval)
+	}
+
+	for _, session := range sessions {
+		session.Close()
+	}
+}
+
+func countActive(sessions []*Session) int {
+	count := 0
+	for _, session := range sessions {
+		if session.IsActive() {
+			count++
+		}
+	}
+	return count
+}
+
+func (s *Session) IsActive() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.isStarted && !s.isEnded
+}
+
+func (s *Session) NotifyShutdown(timeout time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.isEnded {
+		return
+	}
+	if !s.isStarted {
+		timeout = 0
+	}
+
+	event := pb.SessionEvent_ServerShutdownInfo{
+		SecondsLeft: int32(timeout.Seconds()),
+		Resumable:   s.stores.Sessions != nil && s.isStarted,
+	}
+	s.SendEvent(&pb.SessionEvent{
+		EventInfo: &pb.SessionEvent_ShutdownInfo{ShutdownInfo: &event},
+	})
+}
+
+func (s *Session) Close() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.stores.Sessions != nil && s.isStarted && !s.isEnded {
+		s.Checkpoint()
+	} else if !s.isEnded {
+		s.Log().Info("session is terminated by shutdown")
+		s.Finish(pb.Team_UNKNOWN_TEAM)
+	}
+	s.CloseUnlocked()
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"soa_hw_2/internal/pb"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestFinishClosesStreams(t *testing.T) {
+	tests := []struct {
+		name   string
+		finish func(s *Session)
+	}{
+		{"game finished", func(s *Session) {
+			s.mutex.Lock()
+			defer s.mutex.Unlock()
+			s.Finish(pb.Team_MAFIA)
+		}},
+		{"game terminated", func(s *Session) {
+			_ = s.Terminate()
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ms := NewMafiaServer(DefaultGameSettings(), DefaultMatchSettings(), nil, Stores{}, testLogger)
+			s, _ := startedSession(t, DefaultGameSettings(), Stores{})
+			spectator := make(chan *pb.SessionEvent, PlayerEventsBuffer)
+			err := s.AddSpectator(uuid.New(), spectator)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ms.mutex.Lock()
+			ms.addSession(s)
+			ms.mutex.Unlock()
+
+			streams := []chan *pb.SessionEvent{spectator}
+			for _, player := range s.players {
+				streams = append(streams, player.ch)
+			}
+			test.finish(s)
+
+			for _, stream := range streams {
+				finished := false
+				for event := range stream {
+					finished = event.GetFinishInfo() != nil
+				}
+				if !finished {
+					t.Fatal("expected finish event before stream is closed")
+				}
+			}
+
+			sessions := func() int {
+				ms.mutex.Lock()
+				defer ms.mutex.Unlock()
+				return len(ms.sessions)
+			}
+			deadline := time.Now().Add(time.Second)
+			for sessions() > 0 && time.Now().Before(deadline) {
+				time.Sleep(10 * time.Millisecond)
+			}
+			if count := sessions(); count != 0 {
+				t.Fatalf("expected finished session to be removed, got %d sessions", count)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package server
+
+import (
+	"context"
+	"soa_hw_2/internal/pb"
+	"soa_hw_2/internal/store"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const DefaultLeaderboardLimit = 10
+
+var statsRoles = []pb.Role{pb.Role_CIVILIAN, pb.Role_SHERIFF, pb.Role_MAFIA_ROLE}
+var statsTeams = []pb.Team{pb.Team_CIVILIANS, pb.Team_MAFIA}
+
+func (ms *MafiaServer) GetPlayerStats(ctx context.Context, req *pb.PlayerStatsRequest) (*pb.PlayerStats, error) {
+	account, err := ms.getAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ms.stores.Games == nil {
+		return nil, status.Error(codes.Unimplemented, "game history is disabled")
+	}
+
+	username := req.Username
+	if username == "" {
+		username = account.Username
+	}
+	_, err = ms.stores.Accounts.GetByUsername(username)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "player %s is not found", username)
+	}
+
+	records, err := ms.stores.Games.ListByPlayer(username)
+	if err != nil {
+		return nil, err
+	}
+	stats := ComputeStats(username, records)
+	stats.Rating = ms.getRating(username)
+	return stats, nil
+}
+
+func (ms *MafiaServer) GetLeaderboard(ctx context.Context, req *pb.LeaderboardRequest) (*pb.Leaderboard, error) {
+	_, err := ms.getAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ms.stores.Games == nil {
+		return nil, status.Error(codes.Unimplemented, "game history is disabled")
+	}
+
+	records, err := ms.stores.Games.List()
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = DefaultLeaderboardLimit
+	}
+	leaderboard := ComputeLeaderboard(records, limit)
+	for _, entry := range leaderboard.Entries {
+		entry.Rating = ms.getRating(entry.Username)
+	}
+	return leaderboard, nil
+}
+
+func ComputeStats(username string, records []*store.GameRecord) *pb.PlayerStats {
+	stats := &pb.PlayerStats{Username: username}
+	roles := make(map[pb.Role]*pb.PlayerStats_RoleStats)
+	teams := make(map[pb.Team]*pb.PlayerStats_TeamStats)
+	survived, checksFound, kills := 0, 0, 0
+
+	for _, record := range records {
+		player, ok := record.GetPlayer(username)
+		if !ok || record.Winner == pb.Team_UNKNOWN_TEAM {
+			continue
+		}
+
+		team := RoleTeam(player.Role)
+		won := team == record.Winner
+		if roles[player.Role] == nil {
+			roles[player.Role] = &pb.PlayerStats_RoleStats{Role: player.Role}
+		}
+		if teams[team] == nil {
+			teams[team] = &pb.PlayerStats_TeamStats{Team: team}
+		}
+
+		stats.Games++
+		roles[player.Role].Games++
+		teams[team].Games++
+		if won {
+			stats.Wins++
+			roles[player.Role].Wins++
+			teams[team].Wins++
+		} else {
+			stats.Losses++
+		}
+		if player.Survived {
+			survived++
+		}
+
+		for _, phase := range record.Phases {
+			for _, check := range phase.Checks {
+				if check.Username != username {
+					continue
+				}
+				stats.Checks++
+				if check.TargetRole == pb.Role_MAFIA_ROLE {
+					checksFound++
+				}
+			}
+
+			voted, ok := phase.Votes[username]
+			if phase.Phase != pb.Phase_NIGHT || player.Role != pb.Role_MAFIA_ROLE || !ok {
+				continue
+			}
+			stats.MafiaVotes++
+			if phase.Eliminated == voted {
+				kills++
+			}
+		}
+	}
+
+	for _, role := range statsRoles {
+		if roles[role] != nil {
+			stats.Roles = append(stats.Roles, roles[role])
+		}
+	}
+	for _, team := range statsTeams {
+		if teams[team] != nil {
+			stats.Teams = append(stats.Teams, teams[team])
+		}
+	}
+	stats.SurvivalRate = rate(survived, int(stats.Games))
+	stats.CheckAccuracy = rate(checksFound, int(stats.Checks))
+	stats.KillSuccess = rate(kills, int(stats.MafiaVotes))
+	return stats
+}
+
+func ComputeLeaderboard(records []*store.GameRecord, limit int) *pb.Leaderboard {
+	entries := make(map[string]*pb.Leaderboard_Entry)
+	for _, record := range records {
+		if record.Winner == pb.Team_UNKNOWN_TEAM {
+			continue
+		}
+		for _, player := range record.Players {
+			entry, ok := entries[player.Username]
+			if !ok {
+				entry = &pb.Leaderboard_Entry{Username: player.Username}
+				entries[player.Username] = entry
+			}
+			entry.Games++
+			if RoleTeam(player.Role) == record.Winner {
+				entry.Wins++
+			}
+		}
+	}
+
+	leaderboard := &pb.Leaderboard{}
+	for _, entry := range entries {
+		entry.WinRate = rate(int(entry.Wins), int(entry.Games))
+		leaderboard.Entries = append(leaderboard.Entries, entry)
+	}
+	sort.Slice(leaderboard.Entries, func(i, j int) bool {
+		a, b := leaderboard.Entries[i], leaderboard.Entries[j]
+		if a.Wins != b.Wins {
+			return a.Wins > b.Wins
+		}
+		if a.WinRate != b.WinRate {
+			return a.WinRate > b.WinRate
+		}
+		return a.Username < b.Username
+	})
+	if len(leaderboard.Entries) > limit {
+		leaderboard.Entries = leaderboard.Entries[:limit]
+	}
+	return leaderboard
+}
+
+func rate(count int, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(count) / float64(total)
+}
@@ -0,0 +1,159 @@
+package server
+
+import (
+	"soa_hw_2/internal/pb"
+	"soa_hw_2/internal/store"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func testRecords() []*store.GameRecord {
+	return []*store.GameRecord{
+		{Winner: pb.Team_MAFIA,
+			Players: []store.GamePlayer{
+				{Username: "alice", Role: pb.Role_MAFIA_ROLE, Survived: true},
+				{Username: "bob", Role: pb.Role_SHERIFF},
+				{Username: "carol", Role: pb.Role_CIVILIAN},
+				{Username: "dave", Role: pb.Role_CIVILIAN, Survived: true},
+			},
+			Phases: []store.PhaseRecord{
+				{Phase: pb.Phase_NIGHT, Number: 1, Votes: map[string]string{"alice": "carol"}, Eliminated: "carol",
+					Checks: []store.CheckRecord{{Username: "bob", Target: "alice", TargetRole: pb.Role_MAFIA_ROLE}}},
+				{Phase: pb.Phase_DAY, Number: 1, Votes: map[string]string{"alice": "bob", "bob": "alice", "dave": "bob"}, Eliminated: "bob"},
+			}},
+		{Winner: pb.Team_CIVILIANS,
+			Players: []store.GamePlayer{
+				{Username: "alice", Role: pb.Role_SHERIFF, Survived: true},
+				{Username: "bob", Role: pb.Role_MAFIA_ROLE},
+				{Username: "carol", Role: pb.Role_CIVILIAN, Survived: true},
+				{Username: "dave", Role: pb.Role_CIVILIAN},
+			},
+			Phases: []store.PhaseRecord{
+				{Phase: pb.Phase_NIGHT, Number: 1, Votes: map[string]string{"bob": "dave"}, Eliminated: "dave",
+					Checks: []store.CheckRecord{{Username: "alice", Target: "carol", TargetRole: pb.Role_CIVILIAN}}},
+				{Phase: pb.Phase_DAY, Number: 1, Votes: map[string]string{}},
+				// moderator revived the target, so mafia vote did not kill
+				{Phase: pb.Phase_NIGHT, Number: 2, Votes: map[string]string{"bob": "alice"},
+					Checks: []store.CheckRecord{{Username: "alice", Target: "bob", TargetRole: pb.Role_MAFIA_ROLE}}},
+				{Phase: pb.Phase_DAY, Number: 2, Votes: map[string]string{"alice": "bob", "carol": "bob"}, Eliminated: "bob"},
+			}},
+		{Winner: pb.Team_MAFIA,
+			Players: []store.GamePlayer{
+				{Username: "alice", Role: pb.Role_CIVILIAN},
+				{Username: "bob", Role: pb.Role_MAFIA_ROLE, Survived: true},
+				{Username: "carol", Role: pb.Role_SHERIFF},
+				{Username: "dave", Role: pb.Role_CIVILIAN, Survived: true},
+			},
+			Phases: []store.PhaseRecord{
+				{Phase: pb.Phase_NIGHT, Number: 1, Votes: map[string]string{"bob": "alice"}, Eliminated: "alice",
+					Checks: []store.CheckRecord{{Username: "carol", Target: "dave", TargetRole: pb.Role_CIVILIAN}}},
+				{Phase: pb.Phase_DAY, Number: 1, Votes: map[string]string{"bob": "carol", "dave": "carol"}, Eliminated: "carol"},
+			}},
+		{Winner: pb.Team_UNKNOWN_TEAM,
+			Players: []store.GamePlayer{
+				{Username: "alice", Role: pb.Role_MAFIA_ROLE, Survived: true},
+				{Username: "bob", Role: pb.Role_SHERIFF},
+				{Username: "carol", Role: pb.Role_CIVILIAN, Survived: true},
+				{Username: "dave", Role: pb.Role_CIVILIAN, Survived: true},
+			},
+			Phases: []store.PhaseRecord{
+				{Phase: pb.Phase_NIGHT, Number: 1, Votes: map[string]string{"alice": "bob"}, Eliminated: "bob",
+					Checks: []store.CheckRecord{{Username: "bob", Target: "alice", TargetRole: pb.Role_MAFIA_ROLE}}},
+			}},
+	}
+}
+
+func TestComputeStats(t *testing.T) {
+	records := testRecords()
+
+	tests := []struct {
+		name     string
+		username string
+		records  []*store.GameRecord
+		expected *pb.PlayerStats
+	}{
+		{"all roles", "alice", records, &pb.PlayerStats{
+			Username: "alice", Games: 3, Wins: 2, Losses: 1,
+			Roles: []*pb.PlayerStats_RoleStats{
+				{Role: pb.Role_CIVILIAN, Games: 1},
+				{Role: pb.Role_SHERIFF, Games: 1, Wins: 1},
+				{Role: pb.Role_MAFIA_ROLE, Games: 1, Wins: 1},
+			},
+			Teams: []*pb.PlayerStats_TeamStats{
+				{Team: pb.Team_CIVILIANS, Games: 2, Wins: 1},
+				{Team: pb.Team_MAFIA, Games: 1, Wins: 1},
+			},
+			SurvivalRate:  2.0 / 3,
+			Checks:        2,
+			CheckAccuracy: 0.5,
+			MafiaVotes:    1,
+			KillSuccess:   1,
+		}},
+		{"missed kill", "bob", records, &pb.PlayerStats{
+			Username: "bob", Games: 3, Wins: 1, Losses: 2,
+			Roles: []*pb.PlayerStats_RoleStats{
+				{Role: pb.Role_SHERIFF, Games: 1},
+				{Role: pb.Role_MAFIA_ROLE, Games: 2, Wins: 1},
+			},
+			Teams: []*pb.PlayerStats_TeamStats{
+				{Team: pb.Team_CIVILIANS, Games: 1},
+				{Team: pb.Team_MAFIA, Games: 2, Wins: 1},
+			},
+			SurvivalRate:  1.0 / 3,
+			Checks:        1,
+			CheckAccuracy: 1,
+			MafiaVotes:    3,
+			KillSuccess:   2.0 / 3,
+		}},
+		{"only terminated games", "alice", records[3:], &pb.PlayerStats{Username: "alice"}},
+		{"no games", "erin", records, &pb.PlayerStats{Username: "erin"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stats := ComputeStats(test.username, test.records)
+			if !proto.Equal(stats, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, stats)
+			}
+		})
+	}
+}
+
+func TestComputeLeaderboard(t *testing.T) {
+	records := append(testRecords(), &store.GameRecord{Winner: pb.Team_MAFIA,
+		Players: []store.GamePlayer{
+			{Username: "erin", Role: pb.Role_MAFIA_ROLE, Survived: true},
+			{Username: "frank", Role: pb.Role_CIVILIAN},
+		}})
+
+	alice := &pb.Leaderboard_Entry{Username: "alice", Games: 3, Wins: 2, WinRate: 2.0 / 3}
+	erin := &pb.Leaderboard_Entry{Username: "erin", Games: 1, Wins: 1, WinRate: 1}
+	bob := &pb.Leaderboard_Entry{Username: "bob", Games: 3, Wins: 1, WinRate: 1.0 / 3}
+	carol := &pb.Leaderboard_Entry{Username: "carol", Games: 3, Wins: 1, WinRate: 1.0 / 3}
+	dave := &pb.Leaderboard_Entry{Username: "dave", Games: 3, Wins: 1, WinRate: 1.0 / 3}
+	frank := &pb.Leaderboard_Entry{Username: "frank", Games: 1}
+
+	tests := []struct {
+		name     string
+		records  []*store.GameRecord
+		limit    int
+		expected []*pb.Leaderboard_Entry
+	}{
+		{"ordered by wins, win rate and username", records, DefaultLeaderboardLimit, []*pb.Leaderboard_Entry{alice, erin, bob, carol, dave, frank}},
+		{"limit", records, 3, []*pb.Leaderboard_Entry{alice, erin, bob}},
+		{"limit within ties", records, 4, []*pb.Leaderboard_Entry{alice, erin, bob, carol}},
+		{"only terminated games", records[3:4], DefaultLeaderboardLimit, nil},
+		{"no games", nil, DefaultLeaderboardLimit, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			leaderboard := ComputeLeaderboard(test.records, test.limit)
+			expected := &pb.Leaderboard{Entries: test.expected}
+			if !proto.Equal(leaderboard, expected) {
+				t.Fatalf("expected %v, got %v", expected, leaderboard)
+			}
+		})
+	}
+}
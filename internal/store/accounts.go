@@ -0,0 +1,126 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrNotFound = errors.New("not found")
+var ErrExists = errors.New("already exists")
+
+type Account struct {
+	ID           uuid.UUID `json:"id"`
+	Username     string    `json:"username"`
+	PasswordHash []byte    `json:"password_hash"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type AccountStore interface {
+	Create(account *Account) error
+	GetByID(id uuid.UUID) (*Account, error)
+	GetByUsername(username string) (*Account, error)
+}
+
+type FileAccountStore struct {
+	path     string
+	accounts map[uuid.UUID]*Account
+	mutex    sync.Mutex
+}
+
+func NewFileAccountStore(path string) (*FileAccountStore, error) {
+	s := &FileAccountStore{path: path, accounts: make(map[uuid.UUID]*Account)}
+
+	accounts := []*Account{}
+	err := readJSON(path, &accounts)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to load accounts: %s", err)
+	}
+	for _, account := range accounts {
+		s.accounts[account.ID] = account
+	}
+	return s, nil
+}
+
+func (s *FileAccountStore) Create(account *Account) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, a := range s.accounts {
+		if a.Username == account.Username {
+			return ErrExists
+		}
+	}
+
+	s.accounts[account.ID] = account
+	err := s.save()
+	if err != nil {
+		delete(s.accounts, account.ID)
+		return err
+	}
+	return nil
+}
+
+func (s *FileAccountStore) GetByID(id uuid.UUID) (*Account, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	account, ok := s.accounts[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return account, nil
+}
+
+func (s *FileAccountStore) GetByUsername(username string) (*Account, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, account := range s.accounts {
+		if account.Username == username {
+			return account, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (s *FileAccountStore) save() error {
+	accounts := []*Account{}
+	for _, account := range s.accounts {
+		accounts = append(accounts, account)
+	}
+	return writeJSON(s.path, accounts)
+}
+
+func readJSON(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func writeJSON(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	err = os.WriteFile(tmp, data, 0o600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,95 @@
+package store
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestFileAccountStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", "accounts.json")
+	accounts, err := NewFileAccountStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	alice := &Account{ID: uuid.New(), Username: "alice", PasswordHash: []byte("hash"), CreatedAt: time.Now()}
+	bob := &Account{ID: uuid.New(), Username: "bob", PasswordHash: []byte("hash"), CreatedAt: time.Now()}
+	for _, account := range []*Account{alice, bob} {
+		err = accounts.Create(account)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err = accounts.Create(&Account{ID: uuid.New(), Username: "alice"})
+	if !errors.Is(err, ErrExists) {
+		t.Fatalf("expected %s for taken username, got %v", ErrExists, err)
+	}
+
+	reopened, err := NewFileAccountStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		id       uuid.UUID
+		username string
+		expected *Account
+	}{
+		{"first account", alice.ID, "alice", alice},
+		{"second account", bob.ID, "bob", bob},
+		{"unknown account", uuid.New(), "carol", nil},
+		{"username is case sensitive", uuid.Nil, "Alice", nil},
+	}
+
+	for _, test := range tests {
+		for name, store := range map[string]AccountStore{"created": accounts, "reopened": reopened} {
+			t.Run(name+" "+test.name, func(t *testing.T) {
+				byUsername, err := store.GetByUsername(test.username)
+				if test.expected == nil {
+					if !errors.Is(err, ErrNotFound) {
+						t.Fatalf("expected %s, got %v", ErrNotFound, err)
+					}
+					_, err = store.GetByID(test.id)
+					if !errors.Is(err, ErrNotFound) {
+						t.Fatalf("expected %s by id, got %v", ErrNotFound, err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				byID, err := store.GetByID(test.id)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, account := range []*Account{byUsername, byID} {
+					if account.ID != test.expected.ID || account.Username != test.expected.Username ||
+						string(account.PasswordHash) != string(test.expected.PasswordHash) {
+						t.Fatalf("expected %+v, got %+v", test.expected, account)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestFileAccountStoreCorrupted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "accounts.json")
+	err := os.WriteFile(path, []byte("not json"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = NewFileAccountStore(path)
+	if err == nil {
+		t.Fatal("expected error for corrupted accounts file")
+	}
+}
@@ -0,0 +1,150 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"soa_hw_2/internal/pb"
+)
+
+type GamePlayer struct {
+	Username string  `json:"username"`
+	Role     pb.Role `json:"role"`
+	Survived bool    `json:"survived"`
+}
+
+type CheckRecord struct {
+	Username   string  `json:"username"`
+	Target     string  `json:"target"`
+	TargetRole pb.Role `json:"target_role"`
+}
+
+type PhaseRecord struct {
+	Phase      pb.Phase          `json:"phase"`
+	Number     int               `json:"number"`
+	Votes      map[string]string `json:"votes"`
+	Eliminated string            `json:"eliminated,omitempty"`
+	Checks     []CheckRecord     `json:"checks,omitempty"`
+	Removed    []string          `json:"removed,omitempty"`
+}
+
+type EventRecord struct {
+	Offset time.Duration   `json:"offset"`
+	Event  json.RawMessage `json:"event"`
+}
+
+type GameRecord struct {
+	ID         uuid.UUID     `json:"id"`
+	StartedAt  time.Time     `json:"started_at"`
+	FinishedAt time.Time     `json:"finished_at"`
+	Winner     pb.Team       `json:"winner"`
+	Players    []GamePlayer  `json:"players"`
+	Phases     []PhaseRecord `json:"phases"`
+	Events     []EventRecord `json:"events"`
+}
+
+func (r *GameRecord) GetPlayer(username string) (GamePlayer, bool) {
+	for _, player := range r.Players {
+		if player.Username == username {
+			return player, true
+		}
+	}
+	return GamePlayer{}, false
+}
+
+type GameStore interface {
+	Add(record *GameRecord) error
+	Get(id uuid.UUID) (*GameRecord, error)
+	ListByPlayer(username string) ([]*GameRecord, error)
+	List() ([]*GameRecord, error)
+}
+
+type FileGameStore struct {
+	dir   string
+	games map[uuid.UUID]*GameRecord
+	mutex sync.Mutex
+}
+
+func NewFileGameStore(dir string) (*FileGameStore, error) {
+	s := &FileGameStore{dir: dir, games: make(map[uuid.UUID]*GameRecord)}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to load games: %s", err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		record := &GameRecord{}
+		err := readJSON(filepath.Join(dir, entry.Name()), record)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load game %s: %s", entry.Name(), err)
+		}
+		s.games[record.ID] = record
+	}
+	return s, nil
+}
+
+func (s *FileGameStore) Add(record *GameRecord) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	err := writeJSON(filepath.Join(s.dir, record.ID.String()+".json"), record)
+	if err != nil {
+		return err
+	}
+	s.games[record.ID] = record
+	return nil
+}
+
+func (s *FileGameStore) Get(id uuid.UUID) (*GameRecord, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	record, ok := s.games[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return record, nil
+}
+
+func (s *FileGameStore) ListByPlayer(username string) ([]*GameRecord, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	records := []*GameRecord{}
+	for _, record := range s.games {
+		if _, ok := record.GetPlayer(username); ok {
+			records = append(records, record)
+		}
+	}
+	sortByFinish(records)
+	return records, nil
+}
+
+func (s *FileGameStore) List() ([]*GameRecord, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	records := []*GameRecord{}
+	for _, record := range s.games {
+		records = append(records, record)
+	}
+	sortByFinish(records)
+	return records, nil
+}
+
+func sortByFinish(records []*GameRecord) {
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].FinishedAt.After(records[j].FinishedAt)
+	})
+}
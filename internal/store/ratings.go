@@ -0,0 +1,69 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+const DefaultRating = 1000.0
+
+type Rating struct {
+	Username string  `json:"username"`
+	Rating   float64 `json:"rating"`
+	Games    int     `json:"games"`
+}
+
+type RatingStore interface {
+	Get(username string) (*Rating, error)
+	Update(ratings []*Rating) error
+}
+
+type FileRatingStore struct {
+	path    string
+	ratings map[string]*Rating
+	mutex   sync.Mutex
+}
+
+func NewFileRatingStore(path string) (*FileRatingStore, error) {
+	s := &FileRatingStore{path: path, ratings: make(map[string]*Rating)}
+
+	ratings := []*Rating{}
+	err := readJSON(path, &ratings)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to load ratings: %s", err)
+	}
+	for _, rating := range ratings {
+		s.ratings[rating.Username] = rating
+	}
+	return s, nil
+}
+
+func (s *FileRatingStore) Get(username string) (*Rating, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	rating, ok := s.ratings[username]
+	if !ok {
+		return &Rating{Username: username, Rating: DefaultRating}, nil
+	}
+	copied := *rating
+	return &copied, nil
+}
+
+func (s *FileRatingStore) Update(ratings []*Rating) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, rating := range ratings {
+		copied := *rating
+		s.ratings[rating.Username] = &copied
+	}
+
+	all := []*Rating{}
+	for _, rating := range s.ratings {
+		all = append(all, rating)
+	}
+	return writeJSON(s.path, all)
+}
@@ -0,0 +1,93 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"soa_hw_2/internal/pb"
+)
+
+type PlayerSnapshot struct {
+	Username string  `json:"username"`
+	Role     pb.Role `json:"role"`
+	Liveness bool    `json:"liveness"`
+	Kicked   bool    `json:"kicked"`
+}
+
+type SessionSnapshot struct {
+	ID            uuid.UUID         `json:"id"`
+	Host          string            `json:"host"`
+	Players       []PlayerSnapshot  `json:"players"`
+	Moderators    []string          `json:"moderators,omitempty"`
+	State         int               `json:"state"`
+	Votes         map[string]string `json:"votes"`
+	IsChecked     bool              `json:"is_checked"`
+	PhaseDuration time.Duration     `json:"phase_duration"`
+	TimeLeft      time.Duration     `json:"time_left"`
+	IsPaused      bool              `json:"is_paused"`
+	StartedAt     time.Time         `json:"started_at"`
+	Phases        []PhaseRecord     `json:"phases"`
+	Current       PhaseRecord       `json:"current"`
+	Events        []EventRecord     `json:"events"`
+	SavedAt       time.Time         `json:"saved_at"`
+}
+
+type SessionStore interface {
+	Save(snapshot *SessionSnapshot) error
+	Delete(id uuid.UUID) error
+	List() ([]*SessionSnapshot, error)
+}
+
+type FileSessionStore struct {
+	dir string
+}
+
+func NewFileSessionStore(dir string) (*FileSessionStore, error) {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sessions directory: %s", err)
+	}
+	return &FileSessionStore{dir: dir}, nil
+}
+
+func (s *FileSessionStore) Save(snapshot *SessionSnapshot) error {
+	return writeJSON(s.path(snapshot.ID), snapshot)
+}
+
+func (s *FileSessionStore) Delete(id uuid.UUID) error {
+	err := os.Remove(s.path(id))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *FileSessionStore) List() ([]*SessionSnapshot, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := []*SessionSnapshot{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		snapshot := &SessionSnapshot{}
+		err := readJSON(filepath.Join(s.dir, entry.Name()), snapshot)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load session %s: %s", entry.Name(), err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+func (s *FileSessionStore) path(id uuid.UUID) string {
+	return filepath.Join(s.dir, id.String()+".json")
+}
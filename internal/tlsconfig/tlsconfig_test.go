@@ -0,0 +1,242 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"soa_hw_2/internal/pb"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+type authority struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	file string
+}
+
+func newAuthority(t *testing.T, dir string, name string) *authority {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file := filepath.Join(dir, name+".pem")
+	writePEM(t, file, "CERTIFICATE", der)
+	return &authority{cert: cert, key: key, file: file}
+}
+
+func (a *authority) issue(t *testing.T, dir string, name string, usage x509.ExtKeyUsage) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, a.cert, &key.PublicKey, a.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile := filepath.Join(dir, name+".pem")
+	keyFile := filepath.Join(dir, name+"-key.pem")
+	writePEM(t, certFile, "CERTIFICATE", der)
+	writePEM(t, keyFile, "EC PRIVATE KEY", keyDER)
+	return certFile, keyFile
+}
+
+func writePEM(t *testing.T, file string, blockType string, der []byte) {
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	err := os.WriteFile(file, data, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func serve(t *testing.T, creds credentials.TransportCredentials) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := grpc.NewServer(grpc.Creds(creds))
+	pb.RegisterMafiaServer(srv, &pb.UnimplementedMafiaServer{})
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+
+	return lis.Addr().String()
+}
+
+func call(t *testing.T, address string, creds credentials.TransportCredentials) codes.Code {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err = pb.NewMafiaClient(conn).GetSessionState(ctx, &pb.Empty{})
+	return status.Code(err)
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newAuthority(t, dir, "ca")
+	serverCert, serverKey := ca.issue(t, dir, "server", x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey := ca.issue(t, dir, "client", x509.ExtKeyUsageClientAuth)
+
+	untrusted := newAuthority(t, dir, "untrusted")
+	strangerCert, strangerKey := untrusted.issue(t, dir, "stranger", x509.ExtKeyUsageClientAuth)
+
+	serverCreds, err := ServerCredentials(serverCert, serverKey, ca.file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := serve(t, serverCreds)
+
+	tests := []struct {
+		name     string
+		certFile string
+		keyFile  string
+		expected codes.Code
+	}{
+		{"trusted client", clientCert, clientKey, codes.Unimplemented},
+		{"no client certificate", "", "", codes.Unavailable},
+		{"untrusted client", strangerCert, strangerKey, codes.Unavailable},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clientCreds, err := ClientCredentials(true, ca.file, test.certFile, test.keyFile, "localhost")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			code := call(t, address, clientCreds)
+			if code != test.expected {
+				t.Fatalf("expected %s, got %s", test.expected, code)
+			}
+		})
+	}
+}
+
+func TestServerTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newAuthority(t, dir, "ca")
+	serverCert, serverKey := ca.issue(t, dir, "server", x509.ExtKeyUsageServerAuth)
+
+	serverCreds, err := ServerCredentials(serverCert, serverKey, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := serve(t, serverCreds)
+
+	clientCreds, err := ClientCredentials(true, ca.file, "", "", "localhost")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code := call(t, address, clientCreds); code != codes.Unimplemented {
+		t.Fatalf("expected %s, got %s", codes.Unimplemented, code)
+	}
+
+	insecureCreds, err := ClientCredentials(false, "", "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code := call(t, address, insecureCreds); code != codes.Unavailable {
+		t.Fatalf("expected %s, got %s", codes.Unavailable, code)
+	}
+}
+
+func TestClientCredentials(t *testing.T) {
+	dir := t.TempDir()
+	ca := newAuthority(t, dir, "ca")
+	clientCert, clientKey := ca.issue(t, dir, "client", x509.ExtKeyUsageClientAuth)
+
+	tests := []struct {
+		name       string
+		enabled    bool
+		caFile     string
+		certFile   string
+		keyFile    string
+		serverName string
+		expected   string
+	}{
+		{"no options", false, "", "", "", "", "insecure"},
+		{"enabled", true, "", "", "", "", "tls"},
+		{"CA implies TLS", false, ca.file, "", "", "", "tls"},
+		{"server name implies TLS", false, "", "", "", "localhost", "tls"},
+		{"client certificate implies TLS", false, "", clientCert, clientKey, "", "tls"},
+		{"only client key", false, "", "", clientKey, "", ""},
+		{"only client certificate", true, ca.file, clientCert, "", "", ""},
+		{"missing CA file", true, filepath.Join(dir, "missing.pem"), "", "", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			creds, err := ClientCredentials(test.enabled, test.caFile, test.certFile, test.keyFile, test.serverName)
+			if test.expected == "" {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if protocol := creds.Info().SecurityProtocol; protocol != test.expected {
+				t.Fatalf("expected %s credentials, got %s", test.expected, protocol)
+			}
+		})
+	}
+}
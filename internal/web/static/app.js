@@ -0,0 +1,383 @@
+"use strict";
+
+const $ = (id) => document.getElementById(id);
+
+const state = {
+    username: "",
+    token: "",
+    socket: null,
+    spectating: false,
+    requestId: 0,
+    host: "",
+    role: "UNKNOWN_ROLE",
+    phase: "UNKNOWN_PHASE",
+    round: 0,
+    secondsLeft: 0,
+    paused: false,
+    alive: true,
+    started: false,
+    finished: false,
+    ready: false,
+    players: [],
+    lobby: [],
+    checked: {},
+};
+
+const roles = {
+    MAFIA_ROLE: "mafia",
+    CIVILIAN: "civilian",
+    SHERIFF: "sheriff",
+};
+
+function show(view) {
+    for (const id of ["login-view", "rooms-view", "game-view"]) {
+        $(id).hidden = id !== view;
+    }
+}
+
+async function api(method, path, body) {
+    const headers = {"Content-Type": "application/json"};
+    if (state.token) {
+        headers["Authorization"] = "Bearer " + state.token;
+    }
+    const resp = await fetch(path, {method, headers, body: body ? JSON.stringify(body) : undefined});
+    const data = await resp.json().catch(() => ({}));
+    if (!resp.ok) {
+        const err = new Error(data.error || resp.statusText);
+        err.status = resp.status;
+        throw err;
+    }
+    return data;
+}
+
+// Login
+
+$("login-form").addEventListener("submit", async (e) => {
+    e.preventDefault();
+    const account = {username: $("username").value, password: $("password").value};
+    $("login-error").textContent = "";
+    const action = e.submitter && e.submitter.value === "register" ? "register" : "login";
+    try {
+        const resp = await api("POST", "/api/" + action, account);
+        state.username = account.username;
+        state.token = resp.token;
+        sessionStorage.setItem("account", JSON.stringify({username: state.username, token: state.token}));
+        openRooms();
+    } catch (err) {
+        $("login-error").textContent = err.message;
+    }
+});
+
+// Rooms
+
+let roomsTimer = null;
+
+function openRooms() {
+    $("account").textContent = state.username;
+    show("rooms-view");
+    loadRooms();
+    clearInterval(roomsTimer);
+    roomsTimer = setInterval(loadRooms, 5000);
+}
+
+async function loadRooms() {
+    let list;
+    try {
+        list = await api("GET", "/api/sessions");
+    } catch (err) {
+        if (err.status === 401) {
+            logout();
+        }
+        return;
+    }
+    const rooms = $("rooms");
+    rooms.replaceChildren();
+    for (const s of list.sessions || []) {
+        const row = document.createElement("tr");
+        const status = s.isStarted ? `${phaseName(s.phase)}, ${s.aliveCount} alive` : "lobby";
+        for (const text of [s.sessionId, s.host, `${s.playersCount}/${s.maxPlayers}`, status]) {
+            const cell = document.createElement("td");
+            cell.textContent = text;
+            row.append(cell);
+        }
+        const cell = document.createElement("td");
+        if (s.isStarted) {
+            cell.append(button("Watch", () => connect({spectate: s.sessionId})));
+        }
+        row.append(cell);
+        rooms.append(row);
+    }
+    $("no-rooms").hidden = rooms.children.length > 0;
+}
+
+$("refresh").addEventListener("click", loadRooms);
+$("play").addEventListener("click", () => connect({}));
+
+function logout() {
+    clearInterval(roomsTimer);
+    sessionStorage.removeItem("account");
+    state.token = "";
+    $("account").textContent = "";
+    show("login-view");
+}
+
+// Game
+
+function connect(params) {
+    clearInterval(roomsTimer);
+    Object.assign(state, {
+        spectating: Boolean(params.spectate),
+        host: "",
+        role: "UNKNOWN_ROLE",
+        phase: "UNKNOWN_PHASE",
+        round: 0,
+        secondsLeft: 0,
+        paused: false,
+        alive: true,
+        started: false,
+        finished: false,
+        ready: false,
+        players: [],
+        lobby: [],
+        checked: {},
+    });
+    $("chat").replaceChildren();
+    $("banner").hidden = true;
+    $("game-error").textContent = "";
+
+    const query = new URLSearchParams({token: state.token, ...params});
+    const scheme = location.protocol === "https:" ? "wss:" : "ws:";
+    const socket = new WebSocket(`${scheme}//${location.host}/api/ws?${query}`);
+    state.socket = socket;
+
+    socket.onmessage = (message) => handleFrame(JSON.parse(message.data));
+    socket.onclose = (e) => {
+        if (state.socket !== socket) {
+            return;
+        }
+        state.socket = null;
+        if (!state.finished) {
+            system(e.reason || "connection is closed");
+        }
+        render();
+    };
+    show("game-view");
+    render();
+}
+
+function send(action) {
+    if (!state.socket || state.socket.readyState !== WebSocket.OPEN) {
+        return;
+    }
+    state.requestId++;
+    state.socket.send(JSON.stringify({requestId: String(state.requestId), ...action}));
+}
+
+$("leave").addEventListener("click", () => {
+    const socket = state.socket;
+    state.socket = null;
+    if (socket) {
+        socket.close();
+    }
+    openRooms();
+});
+
+$("ready").addEventListener("click", () => send({ready: {ready: !state.ready}}));
+$("start").addEventListener("click", () => send({startGame: {}}));
+
+$("chat-form").addEventListener("submit", (e) => {
+    e.preventDefault();
+    const text = $("chat-text").value.trim();
+    if (text) {
+        send({message: {text}});
+    }
+    $("chat-text").value = "";
+});
+
+function handleFrame(frame) {
+    if (frame.event) {
+        handleEvent(frame.event);
+    } else if (frame.state) {
+        handleState(frame.state);
+    } else if (frame.checkResult) {
+        const r = frame.checkResult;
+        state.checked[r.username] = r.role;
+        system(`${r.username} is ${roles[r.role] || "unknown"}`);
+    } else if (frame.error) {
+        $("game-error").textContent = frame.error.message;
+        return;
+    } else {
+        return;
+    }
+    $("game-error").textContent = "";
+    render();
+}
+
+function handleEvent(event) {
+    if (event.lobbyUpdate) {
+        const info = event.lobbyUpdate;
+        state.host = info.host;
+        state.lobby = info.players || [];
+        const me = state.lobby.find((p) => p.username === state.username);
+        state.ready = Boolean(me && me.ready);
+        $("banner").hidden = false;
+        $("banner").textContent = `Session ${info.sessionId}: ${state.lobby.length}/${info.maxPlayers} players, ${info.minPlayers} needed to start`;
+    } else if (event.startInfo) {
+        const info = event.startInfo;
+        state.started = true;
+        state.role = info.role;
+        state.players = info.players || [];
+        $("banner").hidden = true;
+        system(state.spectating ? "game is started" : `game is started, you are ${roles[info.role] || "a spectator"}`);
+    } else if (event.phaseInfo) {
+        const info = event.phaseInfo;
+        state.started = true;
+        if (info.phase !== state.phase || info.number !== state.round) {
+            system(`${phaseName(info.phase)} ${info.number}`);
+        }
+        state.phase = info.phase;
+        state.round = info.number;
+        state.secondsLeft = info.secondsLeft;
+        state.paused = info.paused;
+        send({getState: {}});
+    } else if (event.finishInfo) {
+        const info = event.finishInfo;
+        state.finished = true;
+        state.players = info.players || state.players;
+        $("banner").hidden = false;
+        $("banner").textContent = finishText(info.winners);
+    } else if (event.chatInfo) {
+        const info = event.chatInfo;
+        const item = document.createElement("li");
+        item.textContent = `${info.username}: ${info.text}`;
+        if (info.channel === "DEAD_CHANNEL") {
+            item.className = "dead";
+        }
+        append(item);
+    } else if (event.voteInfo) {
+        system(`${event.voteInfo.username} voted`);
+    } else if (event.nightActionInfo) {
+        const info = event.nightActionInfo;
+        const verb = info.action === "SHERIFF_CHECK" ? "checked" : "voted for";
+        const result = info.targetRole !== "UNKNOWN_ROLE" ? `, ${roles[info.targetRole]}` : "";
+        system(`${info.username} ${verb} ${info.target}${result}`);
+    } else if (event.joinInfo) {
+        system(`${event.joinInfo.username} joined`);
+    } else if (event.leftInfo) {
+        system(`${event.leftInfo.username} left`);
+    } else if (event.moderatorActionInfo) {
+        const info = event.moderatorActionInfo;
+        system(`moderator: ${info.action.toLowerCase().replace("_", " ")} ${info.username}`.trim());
+    } else if (event.shutdownInfo) {
+        system(`server is shutting down in ${event.shutdownInfo.secondsLeft}s`);
+    }
+}
+
+function handleState(s) {
+    state.players = s.players || [];
+    state.phase = s.phase;
+    if (s.player && s.player.username) {
+        state.alive = s.player.liveness;
+        state.role = s.player.role;
+    }
+}
+
+function render() {
+    const playing = state.socket !== null && !state.spectating && !state.finished;
+    $("ready").hidden = !playing || state.started;
+    $("ready").textContent = state.ready ? "Not ready" : "Ready";
+    $("start").hidden = !playing || state.started || state.host !== state.username;
+    $("chat-form").hidden = !playing;
+    $("role").textContent = roles[state.role] ? `You are ${roles[state.role]}` : "";
+
+    if (!state.started) {
+        $("phase").textContent = state.spectating ? "Spectating" : "Lobby";
+    } else if (state.finished) {
+        $("phase").textContent = "Finished";
+    } else {
+        $("phase").textContent = `${phaseName(state.phase)} ${state.round}`;
+    }
+    renderCountdown();
+
+    const players = $("players");
+    players.replaceChildren();
+    if (!state.started) {
+        for (const p of state.lobby) {
+            const item = document.createElement("li");
+            item.textContent = `${p.username}${p.username === state.host ? " (host)" : ""}${p.ready ? " ✓" : ""}`;
+            players.append(item);
+        }
+        return;
+    }
+    for (const p of state.players) {
+        const item = document.createElement("li");
+        const role = roles[p.role] || roles[state.checked[p.username]];
+        item.textContent = p.username + (role ? ` (${role})` : "");
+        if (!p.liveness) {
+            item.className = "dead";
+        }
+        if (playing && state.alive && p.liveness && p.username !== state.username) {
+            if (state.phase === "DAY" || (state.phase === "NIGHT" && state.role === "MAFIA_ROLE")) {
+                item.append(button("Vote", () => send({vote: {username: p.username}})));
+            }
+            if (state.phase === "NIGHT" && state.role === "SHERIFF") {
+                item.append(button("Check", () => send({check: {username: p.username}})));
+            }
+        }
+        players.append(item);
+    }
+}
+
+function renderCountdown() {
+    if (!state.started || state.finished) {
+        $("countdown").textContent = "";
+    } else if (state.paused) {
+        $("countdown").textContent = "paused";
+    } else {
+        const s = Math.max(state.secondsLeft, 0);
+        $("countdown").textContent = `${Math.floor(s / 60)}:${String(s % 60).padStart(2, "0")}`;
+    }
+}
+
+setInterval(() => {
+    if (state.started && !state.paused && state.secondsLeft > 0) {
+        state.secondsLeft--;
+        renderCountdown();
+    }
+}, 1000);
+
+function phaseName(phase) {
+    return {DAY: "Day", NIGHT: "Night"}[phase] || "";
+}
+
+// winners is omitted from JSON for UNKNOWN_TEAM, which means the game was terminated
+function finishText(winners) {
+    return {MAFIA: "Mafia won", CIVILIANS: "Civilians won"}[winners] || "Game was terminated, nobody won";
+}
+
+function system(text) {
+    const item = document.createElement("li");
+    item.className = "system";
+    item.textContent = text;
+    append(item);
+}
+
+function append(item) {
+    const chat = $("chat");
+    chat.append(item);
+    chat.scrollTop = chat.scrollHeight;
+}
+
+function button(text, onclick) {
+    const b = document.createElement("button");
+    b.textContent = text;
+    b.addEventListener("click", onclick);
+    return b;
+}
+
+const saved = JSON.parse(sessionStorage.getItem("account") || "null");
+if (saved) {
+    state.username = saved.username;
+    state.token = saved.token;
+    openRooms();
+}